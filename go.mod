@@ -1,6 +1,6 @@
 module github.com/johnfercher/go-tree
 
-go 1.21.1
+go 1.23
 
 require github.com/stretchr/testify v1.8.4
 
@@ -0,0 +1,126 @@
+package node
+
+import "sort"
+
+// Clone creates a copy of node and sub-nodes sharing their data.
+func (n *Node[T]) Clone() *Node[T] {
+	return Map(n, func(data T) T {
+		return data
+	})
+}
+
+// CloneWith creates a copy of node and sub-nodes with data copied by copyFn.
+func (n *Node[T]) CloneWith(copyFn func(data T) T) *Node[T] {
+	return Map(n, copyFn)
+}
+
+// Equal retrieves info if both nodes have the same shape, IDs and data, with nexts in the same order.
+func Equal[T any](a *Node[T], b *Node[T], eq func(a, b T) bool) bool {
+	return equal(a, b, eq, false)
+}
+
+// EqualUnordered retrieves info if both nodes have the same shape, IDs and data, matching nexts by ID,
+// nexts sharing an ID are matched by any pairing that makes them equal.
+func EqualUnordered[T any](a *Node[T], b *Node[T], eq func(a, b T) bool) bool {
+	return equal(a, b, eq, true)
+}
+
+func equal[T any](a *Node[T], b *Node[T], eq func(a, b T) bool, unordered bool) bool {
+	type pair struct {
+		a *Node[T]
+		b *Node[T]
+	}
+
+	stack := []pair{{a: a, b: b}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if current.a.id != current.b.id || len(current.a.nexts) != len(current.b.nexts) {
+			return false
+		}
+
+		if !eq(current.a.data, current.b.data) {
+			return false
+		}
+
+		aNexts, bNexts := current.a.nexts, current.b.nexts
+		if !unordered {
+			for i := range aNexts {
+				stack = append(stack, pair{a: aNexts[i], b: bNexts[i]})
+			}
+			continue
+		}
+
+		aNexts, bNexts = sortedByID(aNexts), sortedByID(bNexts)
+		for start, end := 0, 0; start < len(aNexts); start = end {
+			for end = start; end < len(aNexts) && aNexts[end].id == aNexts[start].id; end++ {
+				if bNexts[end].id != aNexts[start].id {
+					return false
+				}
+			}
+
+			if end-start == 1 {
+				stack = append(stack, pair{a: aNexts[start], b: bNexts[start]})
+				continue
+			}
+
+			if !matchAll(aNexts[start:end], bNexts[start:end], eq) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// matchAll retrieves info if siblings sharing an ID can be paired into equal subtrees, trying every pairing.
+func matchAll[T any](aNodes []*Node[T], bNodes []*Node[T], eq func(a, b T) bool) bool {
+	compatible := make([][]bool, len(aNodes))
+	for i := range aNodes {
+		compatible[i] = make([]bool, len(bNodes))
+		for j := range bNodes {
+			compatible[i][j] = equal(aNodes[i], bNodes[j], eq, true)
+		}
+	}
+
+	matchOf := make([]int, len(bNodes))
+	for j := range matchOf {
+		matchOf[j] = -1
+	}
+
+	// augment looks for a pairing of i, moving already paired nodes when needed.
+	var augment func(i int, seen []bool) bool
+	augment = func(i int, seen []bool) bool {
+		for j := range bNodes {
+			if !compatible[i][j] || seen[j] {
+				continue
+			}
+			seen[j] = true
+
+			if matchOf[j] < 0 || augment(matchOf[j], seen) {
+				matchOf[j] = i
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i := range aNodes {
+		if !augment(i, make([]bool, len(bNodes))) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedByID[T any](nodes []*Node[T]) []*Node[T] {
+	sorted := append([]*Node[T](nil), nodes...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].id < sorted[j].id
+	})
+
+	return sorted
+}
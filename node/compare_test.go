@@ -0,0 +1,131 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func intEq(a, b int) bool {
+	return a == b
+}
+
+func TestNode_Clone_ShouldCopyStructureSharingData(t *testing.T) {
+	// Arrange
+	value := &anyType{Value: "shared"}
+	sut := node.New(value).WithID(0)
+	sut.AddNext(node.New(&anyType{Value: "next"}).WithID(1))
+
+	// Act
+	cloned := sut.Clone()
+
+	// Assert
+	assert.NotSame(t, sut, cloned)
+	assert.NotSame(t, sut.GetNexts()[0], cloned.GetNexts()[0])
+	assert.Same(t, value, cloned.GetData())
+	assert.Equal(t, sut.GetStructure(), cloned.GetStructure())
+}
+
+func TestNode_CloneWith_ShouldCopyData(t *testing.T) {
+	// Arrange
+	value := &anyType{Value: "copied"}
+	sut := node.New(value).WithID(0)
+
+	// Act
+	cloned := sut.CloneWith(func(data *anyType) *anyType {
+		copied := *data
+		return &copied
+	})
+
+	// Assert
+	assert.NotSame(t, value, cloned.GetData())
+	assert.Equal(t, value, cloned.GetData())
+}
+
+func TestEqual_WhenNodesAreEqual_ShouldReturnTrue(t *testing.T) {
+	// Arrange
+	a := buildTraversalNode()
+	b := buildTraversalNode()
+
+	// Act & Assert
+	assert.True(t, node.Equal(a, b, intEq))
+	assert.True(t, node.EqualUnordered(a, b, intEq))
+}
+
+func TestEqual_WhenNodesDiffer_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	base := buildTraversalNode()
+
+	extraNext := buildTraversalNode()
+	extraNext.GetNexts()[0].GetNexts()[0].AddNext(node.New(6).WithID(6))
+
+	differentID := buildTraversalNode()
+	differentID.GetNexts()[1].GetNexts()[0].WithID(42)
+
+	differentShape := buildTraversalNode()
+	differentShape.GetNexts()[1].GetNexts()[0].SetParent(differentShape)
+
+	// Act & Assert
+	assert.False(t, node.Equal(base, extraNext, intEq))
+	assert.False(t, node.Equal(base, differentID, intEq))
+	assert.False(t, node.Equal(base, differentShape, intEq))
+	assert.False(t, node.EqualUnordered(base, differentShape, intEq))
+	assert.False(t, node.Equal(base, buildTraversalNode(), func(a, b int) bool {
+		return a != 5
+	}))
+}
+
+func TestEqual_WhenNextsAreReordered_ShouldDependOnMode(t *testing.T) {
+	// Arrange
+	a := buildTraversalNode()
+	b := buildTraversalNode()
+	b.SwapSiblings(1, 2)
+	b.GetNexts()[1].SwapSiblings(3, 4)
+
+	// Act & Assert
+	assert.False(t, node.Equal(a, b, intEq))
+	assert.True(t, node.EqualUnordered(a, b, intEq))
+}
+
+func TestEqualUnordered_WhenSiblingsShareID_ShouldTryEveryPairing(t *testing.T) {
+	// Arrange
+	build := func(first, second int, firstLeaf int) *node.Node[int] {
+		root := node.New(0).WithID(0)
+		a := node.New(first).WithID(1)
+		a.AddNext(node.New(firstLeaf).WithID(2))
+		root.AddNext(a)
+		root.AddNext(node.New(second).WithID(1))
+		return root
+	}
+	swapped := node.New(0).WithID(0)
+	swapped.AddNext(node.New(20).WithID(1))
+	b := node.New(10).WithID(1)
+	b.AddNext(node.New(30).WithID(2))
+	swapped.AddNext(b)
+
+	// Act & Assert
+	assert.True(t, node.EqualUnordered(build(10, 20, 30), swapped, intEq))
+	assert.False(t, node.Equal(build(10, 20, 30), swapped, intEq))
+	assert.False(t, node.EqualUnordered(build(10, 20, 31), swapped, intEq))
+	assert.False(t, node.EqualUnordered(build(20, 20, 30), swapped, intEq))
+}
+
+func TestEqualUnordered_WhenFirstPairingFails_ShouldRepairIt(t *testing.T) {
+	// Arrange
+	near := func(a, b int) bool {
+		return a-b <= 1 && b-a <= 1
+	}
+	a := node.New(0).WithID(0)
+	a.AddNext(node.New(2).WithID(1))
+	a.AddNext(node.New(1).WithID(1))
+	b := node.New(0).WithID(0)
+	b.AddNext(node.New(2).WithID(1))
+	b.AddNext(node.New(3).WithID(1))
+
+	// Act
+	equal := node.EqualUnordered(a, b, near)
+
+	// Assert
+	assert.True(t, equal)
+}
@@ -0,0 +1,101 @@
+package node
+
+// FilterMode defines which nodes are kept by FilterWithMode.
+type FilterMode int
+
+const (
+	// Prune keeps nodes that respect the rule while all their ancestors also do, as Filter.
+	Prune FilterMode = iota
+	// KeepAncestors keeps nodes that respect the rule and their ancestors.
+	KeepAncestors
+	// KeepSubtrees keeps nodes that respect the rule, their ancestors and their sub-nodes.
+	KeepSubtrees
+)
+
+// KeepReason defines why a node was kept by FilterWithReasons.
+type KeepReason int
+
+const (
+	// Matched nodes respect the rule.
+	Matched KeepReason = iota
+	// AncestorOfMatch nodes don´t respect the rule but have sub-nodes that do.
+	AncestorOfMatch
+	// DescendantOfMatch nodes don´t respect the rule but have an ancestor that does.
+	DescendantOfMatch
+)
+
+// FilterWithMode creates a copy of node keeping the sub-nodes defined by mode.
+func (n *Node[T]) FilterWithMode(filterFunc func(obj T) bool, mode FilterMode) (*Node[T], bool) {
+	filtered, _, ok := n.FilterWithReasons(filterFunc, mode)
+	return filtered, ok
+}
+
+// FilterWithReasons creates a copy of node keeping the sub-nodes defined by mode,
+// retrieving why each copied node was kept.
+func (n *Node[T]) FilterWithReasons(filterFunc func(obj T) bool, mode FilterMode) (*Node[T], map[*Node[T]]KeepReason, bool) {
+	matched := make(map[*Node[T]]bool)
+	matchBelow := make(map[*Node[T]]bool)
+
+	if mode != Prune {
+		for current := range n.PostOrder() {
+			matched[current] = filterFunc(current.data)
+
+			below := matched[current]
+			for _, next := range current.nexts {
+				below = below || matchBelow[next]
+			}
+			matchBelow[current] = below
+		}
+	}
+
+	type state struct {
+		filtered   *Node[T]
+		underMatch bool
+	}
+
+	states := make(map[*Node[T]]state)
+	reasons := make(map[*Node[T]]KeepReason)
+	var root *Node[T]
+
+	n.Walk(func(current *Node[T], depth int) WalkAction {
+		var parent state
+		if depth > 0 {
+			parent = states[current.previous]
+		}
+
+		isMatch := matched[current]
+		if mode == Prune {
+			isMatch = filterFunc(current.data)
+		}
+
+		var reason KeepReason
+		switch {
+		case isMatch:
+			reason = Matched
+		case mode == KeepSubtrees && parent.underMatch:
+			reason = DescendantOfMatch
+		case mode != Prune && matchBelow[current]:
+			reason = AncestorOfMatch
+		default:
+			return SkipChildren
+		}
+
+		filtered := New(current.data).WithID(current.id)
+		if depth == 0 {
+			root = filtered
+		} else {
+			parent.filtered.AddNext(filtered)
+		}
+
+		reasons[filtered] = reason
+		states[current] = state{filtered: filtered, underMatch: parent.underMatch || isMatch}
+
+		return Continue
+	})
+
+	if root == nil {
+		return nil, nil, false
+	}
+
+	return root, reasons, true
+}
@@ -0,0 +1,122 @@
+package node_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/stretchr/testify/assert"
+)
+
+// buildFileNode builds a file tree where main.go is under a directory that is not a go file.
+func buildFileNode() *node.Node[string] {
+	root := node.New("project").WithID(0)
+	cmd := node.New("cmd").WithID(1)
+	docs := node.New("docs").WithID(2)
+	pkg := node.New("pkg.go").WithID(3)
+
+	root.AddNext(cmd)
+	root.AddNext(docs)
+	root.AddNext(pkg)
+	cmd.AddNext(node.New("main.go").WithID(4))
+	cmd.AddNext(node.New("README.md").WithID(5))
+	docs.AddNext(node.New("index.md").WithID(6))
+	pkg.AddNext(node.New("notes.txt").WithID(7))
+
+	return root
+}
+
+func isGoFile(name string) bool {
+	return strings.HasSuffix(name, ".go")
+}
+
+func isProjectOrGoFile(name string) bool {
+	return name == "project" || isGoFile(name)
+}
+
+func filteredIDs(n *node.Node[string]) []int {
+	var ids []int
+	for current := range n.PreOrder() {
+		ids = append(ids, current.GetID())
+	}
+
+	return ids
+}
+
+func TestNode_FilterWithMode_WhenPrune_ShouldBehaveAsFilter(t *testing.T) {
+	// Arrange
+	sut := buildFileNode()
+
+	// Act
+	filtered, ok := sut.FilterWithMode(isProjectOrGoFile, node.Prune)
+	expected, _ := sut.Filter(isProjectOrGoFile)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 3}, filteredIDs(filtered))
+	assert.Equal(t, expected.GetStructure(), filtered.GetStructure())
+}
+
+func TestNode_FilterWithMode_WhenKeepAncestors_ShouldKeepPathToMatches(t *testing.T) {
+	// Arrange
+	sut := buildFileNode()
+
+	// Act
+	filtered, ok := sut.FilterWithMode(isGoFile, node.KeepAncestors)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 1, 4, 3}, filteredIDs(filtered))
+}
+
+func TestNode_FilterWithMode_WhenKeepSubtrees_ShouldKeepSubNodesOfMatches(t *testing.T) {
+	// Arrange
+	sut := buildFileNode()
+
+	// Act
+	filtered, ok := sut.FilterWithMode(isGoFile, node.KeepSubtrees)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []int{0, 1, 4, 3, 7}, filteredIDs(filtered))
+}
+
+func TestNode_FilterWithMode_WhenNothingMatches_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	sut := buildFileNode()
+	never := func(string) bool {
+		return false
+	}
+
+	for _, mode := range []node.FilterMode{node.Prune, node.KeepAncestors, node.KeepSubtrees} {
+		// Act
+		filtered, ok := sut.FilterWithMode(never, mode)
+
+		// Assert
+		assert.False(t, ok)
+		assert.Nil(t, filtered)
+	}
+}
+
+func TestNode_FilterWithReasons_ShouldTellMatchesFromContext(t *testing.T) {
+	// Arrange
+	sut := buildFileNode()
+
+	// Act
+	filtered, reasons, ok := sut.FilterWithReasons(isGoFile, node.KeepSubtrees)
+
+	// Assert
+	assert.True(t, ok)
+	byID := make(map[int]node.KeepReason)
+	for n, reason := range reasons {
+		byID[n.GetID()] = reason
+	}
+	assert.Equal(t, map[int]node.KeepReason{
+		0: node.AncestorOfMatch,
+		1: node.AncestorOfMatch,
+		4: node.Matched,
+		3: node.Matched,
+		7: node.DescendantOfMatch,
+	}, byID)
+	assert.Equal(t, node.AncestorOfMatch, reasons[filtered])
+}
@@ -0,0 +1,104 @@
+package node
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// MaxJSONDepth is the deepest node, root has depth 0, that the nested format can hold
+// within the nesting limit of encoding/json, deeper trees should use tree.FlatJSON.
+const MaxJSONDepth = 4999
+
+// ErrJSONTooDeep is returned when encoding a node deeper than MaxJSONDepth.
+var ErrJSONTooDeep = errors.New("node too deep for nested JSON")
+
+type jsonNode[T any] struct {
+	ID       int            `json:"id"`
+	Data     T              `json:"data"`
+	Children []*jsonNode[T] `json:"children,omitempty"`
+}
+
+// MarshalJSON encodes node and sub-nodes as {"id":..,"data":..,"children":[...]},
+// sub-nodes deeper than MaxJSONDepth return ErrJSONTooDeep.
+func (n *Node[T]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	var err error
+
+	n.WalkEnterExit(func(current *Node[T], depth int) WalkAction {
+		if current.previous != nil && depth > 0 && current.previous.nexts[0] != current {
+			buf.WriteByte(',')
+		}
+
+		if depth > MaxJSONDepth {
+			err = fmt.Errorf("%w: depth %d exceeds %d", ErrJSONTooDeep, depth, MaxJSONDepth)
+			return Stop
+		}
+
+		data, dataErr := json.Marshal(current.data)
+		if dataErr != nil {
+			err = dataErr
+			return Stop
+		}
+
+		buf.WriteString(`{"id":`)
+		buf.WriteString(strconv.Itoa(current.id))
+		buf.WriteString(`,"data":`)
+		buf.Write(data)
+
+		if len(current.nexts) > 0 {
+			buf.WriteString(`,"children":[`)
+		}
+
+		return Continue
+	}, func(current *Node[T], depth int) {
+		if len(current.nexts) > 0 {
+			buf.WriteByte(']')
+		}
+		buf.WriteByte('}')
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes node and sub-nodes from {"id":..,"data":..,"children":[...]},
+// encoding/json rejects documents deeper than MaxJSONDepth.
+func (n *Node[T]) UnmarshalJSON(data []byte) error {
+	decoded := &jsonNode[T]{}
+	if err := json.Unmarshal(data, decoded); err != nil {
+		return err
+	}
+
+	n.id = decoded.ID
+	n.data = decoded.Data
+	n.nexts = nil
+
+	type pair struct {
+		decoded *jsonNode[T]
+		node    *Node[T]
+	}
+
+	stack := []pair{{decoded: decoded, node: n}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, child := range current.decoded.Children {
+			if child == nil {
+				continue
+			}
+
+			next := New(child.Data).WithID(child.ID)
+			current.node.AddNext(next)
+			stack = append(stack, pair{decoded: child, node: next})
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package node_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_MarshalJSON_ShouldEncodeNested(t *testing.T) {
+	// Arrange
+	root := node.New("a").WithID(0)
+	n1 := node.New("b").WithID(1)
+	root.AddNext(n1)
+	root.AddNext(node.New("c").WithID(2))
+	n1.AddNext(node.New("d").WithID(3))
+
+	// Act
+	bytes, err := json.Marshal(root)
+
+	// Assert
+	assert.Nil(t, err)
+	expected := `{"id":0,"data":"a","children":[{"id":1,"data":"b","children":[{"id":3,"data":"d"}]},{"id":2,"data":"c"}]}`
+	assert.Equal(t, expected, string(bytes))
+}
+
+func TestNode_MarshalJSON_WhenNodeIsNotRoot_ShouldEncodeOnlySubtree(t *testing.T) {
+	// Arrange
+	root := node.New(0).WithID(0)
+	n1 := node.New(1).WithID(1)
+	root.AddNext(node.New(2).WithID(2))
+	root.AddNext(n1)
+
+	// Act
+	bytes, err := json.Marshal(n1)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, `{"id":1,"data":1}`, string(bytes))
+}
+
+func TestNode_MarshalJSON_WhenDataCannotBeEncoded_ShouldReturnError(t *testing.T) {
+	// Arrange
+	root := node.New[any](0)
+	root.AddNext(node.New[any](func() {}))
+
+	// Act
+	bytes, err := json.Marshal(root)
+
+	// Assert
+	assert.NotNil(t, err)
+	assert.Nil(t, bytes)
+}
+
+func TestNode_UnmarshalJSON_ShouldRoundTrip(t *testing.T) {
+	// Arrange
+	input := `{"id":0,"data":"a","children":[{"id":1,"data":"b","children":[{"id":3,"data":"d"}]},{"id":2,"data":"c"}]}`
+	var sut node.Node[string]
+
+	// Act
+	err := json.Unmarshal([]byte(input), &sut)
+	output, _ := json.Marshal(&sut)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, input, string(output))
+	assert.Equal(t, &sut, sut.GetNexts()[0].GetPrevious())
+	assert.Equal(t, "d", sut.GetNexts()[0].GetNexts()[0].GetData())
+}
+
+func TestNode_UnmarshalJSON_WhenInputIsInvalid_ShouldReturnError(t *testing.T) {
+	// Arrange
+	var sut node.Node[int]
+
+	// Act
+	err := json.Unmarshal([]byte(`{"id":"zero"}`), &sut)
+
+	// Assert
+	assert.NotNil(t, err)
+}
+
+func TestNode_MarshalJSON_WhenDepthIsMax_ShouldRoundTrip(t *testing.T) {
+	// Arrange
+	root, _ := buildChain(node.MaxJSONDepth + 1)
+
+	// Act
+	bytes, err := json.Marshal(root)
+	decoded := &node.Node[int]{}
+	decodeErr := json.Unmarshal(bytes, decoded)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Nil(t, decodeErr)
+	assert.True(t, node.Equal(root, decoded, intEq))
+}
+
+func TestNode_MarshalJSON_WhenDepthExceedsMax_ShouldReturnErrJSONTooDeep(t *testing.T) {
+	// Arrange
+	root, _ := buildChain(node.MaxJSONDepth + 2)
+
+	// Act
+	bytes, err := json.Marshal(root)
+
+	// Assert
+	assert.Nil(t, bytes)
+	assert.ErrorIs(t, err, node.ErrJSONTooDeep)
+}
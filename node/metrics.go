@@ -0,0 +1,50 @@
+package node
+
+// Depth retrieves the number of edges from node to root.
+func (n *Node[T]) Depth() int {
+	depth := 0
+	for current := n.previous; current != nil; current = current.previous {
+		depth++
+	}
+
+	return depth
+}
+
+// Height retrieves the number of edges from node to its deepest leaf.
+func (n *Node[T]) Height() int {
+	height := 0
+	for _, depth := range n.PreOrderWithDepth() {
+		if depth > height {
+			height = depth
+		}
+	}
+
+	return height
+}
+
+// Size retrieves the number of nodes in the subtree of node, node included.
+func (n *Node[T]) Size() int {
+	size := 0
+	for range n.PreOrder() {
+		size++
+	}
+
+	return size
+}
+
+// Degree retrieves the number of nexts of node.
+func (n *Node[T]) Degree() int {
+	return len(n.nexts)
+}
+
+// LeafCount retrieves the number of leaves in the subtree of node.
+func (n *Node[T]) LeafCount() int {
+	leaves := 0
+	for current := range n.PreOrder() {
+		if current.IsLeaf() {
+			leaves++
+		}
+	}
+
+	return leaves
+}
@@ -0,0 +1,58 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_Metrics_WhenNodeIsRoot_ShouldDescribeWholeTree(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act & Assert
+	assert.Equal(t, 0, sut.Depth())
+	assert.Equal(t, 2, sut.Height())
+	assert.Equal(t, 6, sut.Size())
+	assert.Equal(t, 2, sut.Degree())
+	assert.Equal(t, 3, sut.LeafCount())
+}
+
+func TestNode_Metrics_WhenNodeIsInner_ShouldDescribeSubtree(t *testing.T) {
+	// Arrange
+	root := buildTraversalNode()
+	sut := root.GetNexts()[0]
+
+	// Act & Assert
+	assert.Equal(t, 1, sut.Depth())
+	assert.Equal(t, 1, sut.Height())
+	assert.Equal(t, 3, sut.Size())
+	assert.Equal(t, 2, sut.Degree())
+	assert.Equal(t, 2, sut.LeafCount())
+}
+
+func TestNode_Metrics_WhenNodeIsLeaf_ShouldBeMinimal(t *testing.T) {
+	// Arrange
+	root := buildTraversalNode()
+	sut := root.GetNexts()[1].GetNexts()[0]
+
+	// Act & Assert
+	assert.Equal(t, 2, sut.Depth())
+	assert.Equal(t, 0, sut.Height())
+	assert.Equal(t, 1, sut.Size())
+	assert.Equal(t, 0, sut.Degree())
+	assert.Equal(t, 1, sut.LeafCount())
+}
+
+func TestNode_Metrics_WhenNodeIsAlone_ShouldBeMinimal(t *testing.T) {
+	// Arrange
+	sut := node.New(0)
+
+	// Act & Assert
+	assert.Equal(t, 0, sut.Depth())
+	assert.Equal(t, 0, sut.Height())
+	assert.Equal(t, 1, sut.Size())
+	assert.Equal(t, 0, sut.Degree())
+	assert.Equal(t, 1, sut.LeafCount())
+}
@@ -2,6 +2,7 @@ package node
 
 import (
 	"fmt"
+	"sort"
 )
 
 // nolint:structcheck,gocritic
@@ -26,6 +27,11 @@ func (n *Node[T]) WithID(id int) *Node[T] {
 	return n
 }
 
+// SetData replaces data of node.
+func (n *Node[T]) SetData(data T) {
+	n.data = data
+}
+
 // GetData retrieves data from node.
 func (n *Node[T]) GetData() T {
 	return n.data
@@ -72,23 +78,20 @@ func (n *Node[T]) Backtrack() []*Node[T] {
 // GetStructure retrieves the node structure.
 func (n *Node[T]) GetStructure() []string {
 	var structure []string
-	var current string
-
-	if n.previous == nil {
-		current = fmt.Sprintf("(NULL) -> (%d)", n.id)
-	} else {
-		current = fmt.Sprintf("(%d) -> (%d)", n.previous.id, n.id)
-	}
 
-	if n.nexts != nil {
-		current += ", "
-	}
+	for current := range n.PreOrder() {
+		var line string
+		if current.previous == nil {
+			line = fmt.Sprintf("(NULL) -> (%d)", current.id)
+		} else {
+			line = fmt.Sprintf("(%d) -> (%d)", current.previous.id, current.id)
+		}
 
-	structure = append(structure, current)
+		if current.nexts != nil {
+			line += ", "
+		}
 
-	for _, next := range n.nexts {
-		innerStructure := next.GetStructure()
-		structure = append(structure, innerStructure...)
+		structure = append(structure, line)
 	}
 
 	return structure
@@ -100,6 +103,139 @@ func (n *Node[T]) AddNext(node *Node[T]) {
 	n.nexts = append(n.nexts, node)
 }
 
+// Detach removes node from its parent, keeping its nexts.
+func (n *Node[T]) Detach() *Node[T] {
+	if n.previous == nil {
+		return n
+	}
+
+	parent := n.previous
+	if index := parent.indexOf(n); index >= 0 {
+		parent.nexts = append(parent.nexts[:index], parent.nexts[index+1:]...)
+	}
+	n.previous = nil
+
+	return n
+}
+
+// DetachAndPromote removes node from its parent placing its nexts in its position.
+func (n *Node[T]) DetachAndPromote() bool {
+	if n.previous == nil {
+		return false
+	}
+
+	parent := n.previous
+	index := parent.indexOf(n)
+	if index < 0 {
+		return false
+	}
+
+	nexts := make([]*Node[T], 0, len(parent.nexts)-1+len(n.nexts))
+	nexts = append(nexts, parent.nexts[:index]...)
+	nexts = append(nexts, n.nexts...)
+	nexts = append(nexts, parent.nexts[index+1:]...)
+
+	for _, next := range n.nexts {
+		next.previous = parent
+	}
+
+	parent.nexts = nexts
+	n.nexts = nil
+	n.previous = nil
+
+	return true
+}
+
+// SetParent moves node to the end of parent nexts, it doesn´t move a node under itself.
+func (n *Node[T]) SetParent(parent *Node[T]) bool {
+	nexts := len(parent.nexts)
+	if n.previous == parent {
+		nexts--
+	}
+
+	return n.SetParentAt(parent, nexts)
+}
+
+// SetParentAt moves node to a position of parent nexts, it doesn´t move a node under itself.
+func (n *Node[T]) SetParentAt(parent *Node[T], index int) bool {
+	for current := parent; current != nil; current = current.previous {
+		if current == n {
+			return false
+		}
+	}
+
+	nexts := len(parent.nexts)
+	if n.previous == parent {
+		nexts--
+	}
+
+	if index < 0 || index > nexts {
+		return false
+	}
+
+	n.Detach()
+	parent.insertAt(index, n)
+
+	return true
+}
+
+// InsertNextAt adds node to current node at an index of nexts.
+func (n *Node[T]) InsertNextAt(index int, node *Node[T]) bool {
+	return node.SetParentAt(n, index)
+}
+
+// InsertBefore adds node to current node before the next with siblingID.
+func (n *Node[T]) InsertBefore(siblingID int, node *Node[T]) bool {
+	index := n.indexOfID(siblingID, node)
+	if index < 0 {
+		return false
+	}
+
+	return node.SetParentAt(n, index)
+}
+
+// InsertAfter adds node to current node after the next with siblingID.
+func (n *Node[T]) InsertAfter(siblingID int, node *Node[T]) bool {
+	index := n.indexOfID(siblingID, node)
+	if index < 0 {
+		return false
+	}
+
+	return node.SetParentAt(n, index+1)
+}
+
+// SwapSiblings swaps the positions of two nexts of current node.
+func (n *Node[T]) SwapSiblings(firstID, secondID int) bool {
+	first := n.indexOfID(firstID, nil)
+	second := n.indexOfID(secondID, nil)
+	if first < 0 || second < 0 {
+		return false
+	}
+
+	n.nexts[first], n.nexts[second] = n.nexts[second], n.nexts[first]
+
+	return true
+}
+
+// SortChildren sorts nexts of current node keeping the order of equal elements.
+func (n *Node[T]) SortChildren(less func(a, b T) bool) {
+	sort.SliceStable(n.nexts, func(i, j int) bool {
+		return less(n.nexts[i].data, n.nexts[j].data)
+	})
+}
+
+// SortDeep sorts nexts of current node and all sub-nodes.
+func (n *Node[T]) SortDeep(less func(a, b T) bool) {
+	stack := []*Node[T]{n}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		current.SortChildren(less)
+		stack = append(stack, current.nexts...)
+	}
+}
+
 // Filter remove all sub-nodes that doesn´t respect a rule.
 func (n *Node[T]) Filter(filterFunc func(obj T) bool) (*Node[T], bool) {
 	if !filterFunc(n.GetData()) {
@@ -108,12 +244,64 @@ func (n *Node[T]) Filter(filterFunc func(obj T) bool) (*Node[T], bool) {
 
 	newNode := New(n.GetData()).WithID(n.GetID())
 
-	for _, next := range n.nexts {
-		innerNode, ok := next.Filter(filterFunc)
-		if ok {
-			newNode.AddNext(innerNode)
+	type pair struct {
+		original *Node[T]
+		filtered *Node[T]
+	}
+
+	stack := []pair{{original: n, filtered: newNode}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		var kept []pair
+		for _, next := range current.original.nexts {
+			if !filterFunc(next.GetData()) {
+				continue
+			}
+
+			innerNode := New(next.GetData()).WithID(next.GetID())
+			current.filtered.AddNext(innerNode)
+			kept = append(kept, pair{original: next, filtered: innerNode})
+		}
+
+		for i := len(kept) - 1; i >= 0; i-- {
+			stack = append(stack, kept[i])
 		}
 	}
 
 	return newNode, true
 }
+
+func (n *Node[T]) insertAt(index int, next *Node[T]) {
+	next.previous = n
+	n.nexts = append(n.nexts, nil)
+	copy(n.nexts[index+1:], n.nexts[index:])
+	n.nexts[index] = next
+}
+
+// indexOfID retrieves the index of the next with id, ignoring skip.
+func (n *Node[T]) indexOfID(id int, skip *Node[T]) int {
+	index := 0
+	for _, current := range n.nexts {
+		if current == skip {
+			continue
+		}
+		if current.id == id {
+			return index
+		}
+		index++
+	}
+
+	return -1
+}
+
+func (n *Node[T]) indexOf(next *Node[T]) int {
+	for i, current := range n.nexts {
+		if current == next {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -367,3 +367,372 @@ func TestNode_Filter(t *testing.T) {
 	nexts := newN0.GetNexts()
 	assert.Equal(t, 2, nexts[0].GetID())
 }
+
+func TestNode_Detach_WhenNodeHasParent_ShouldRemoveFromParent(t *testing.T) {
+	// Arrange
+	root := node.New(0).WithID(0)
+	n1 := node.New(1).WithID(1)
+	n2 := node.New(2).WithID(2)
+	n3 := node.New(3).WithID(3)
+	root.AddNext(n1)
+	root.AddNext(n2)
+	root.AddNext(n3)
+	n2.AddNext(node.New(4).WithID(4))
+
+	// Act
+	detached := n2.Detach()
+
+	// Assert
+	assert.Equal(t, n2, detached)
+	assert.True(t, detached.IsRoot())
+	assert.Equal(t, 1, len(detached.GetNexts()))
+	assert.Equal(t, []*node.Node[int]{n1, n3}, root.GetNexts())
+}
+
+func TestNode_Detach_WhenNodeIsRoot_ShouldKeepNode(t *testing.T) {
+	// Arrange
+	root := node.New(0)
+	root.AddNext(node.New(1))
+
+	// Act
+	detached := root.Detach()
+
+	// Assert
+	assert.Equal(t, root, detached)
+	assert.Equal(t, 1, len(root.GetNexts()))
+}
+
+func TestNode_DetachAndPromote_WhenNodeHasParent_ShouldSpliceNexts(t *testing.T) {
+	// Arrange
+	root := node.New(0).WithID(0)
+	n1 := node.New(1).WithID(1)
+	n2 := node.New(2).WithID(2)
+	n3 := node.New(3).WithID(3)
+	n4 := node.New(4).WithID(4)
+	n5 := node.New(5).WithID(5)
+	root.AddNext(n1)
+	root.AddNext(n2)
+	root.AddNext(n3)
+	n2.AddNext(n4)
+	n2.AddNext(n5)
+
+	// Act
+	ok := n2.DetachAndPromote()
+
+	// Assert
+	assert.True(t, ok)
+	assert.True(t, n2.IsRoot())
+	assert.True(t, n2.IsLeaf())
+	assert.Equal(t, []*node.Node[int]{n1, n4, n5, n3}, root.GetNexts())
+	assert.Equal(t, root, n4.GetPrevious())
+	assert.Equal(t, root, n5.GetPrevious())
+}
+
+func TestNode_DetachAndPromote_WhenNodeIsRoot_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	root := node.New(0)
+	root.AddNext(node.New(1))
+
+	// Act
+	ok := root.DetachAndPromote()
+
+	// Assert
+	assert.False(t, ok)
+	assert.Equal(t, 1, len(root.GetNexts()))
+}
+
+func TestNode_SetParent_WhenParentIsNotDescendant_ShouldMoveNode(t *testing.T) {
+	// Arrange
+	root := node.New(0).WithID(0)
+	n1 := node.New(1).WithID(1)
+	n2 := node.New(2).WithID(2)
+	n3 := node.New(3).WithID(3)
+	root.AddNext(n1)
+	root.AddNext(n2)
+	n1.AddNext(n3)
+
+	// Act
+	ok := n3.SetParent(n2)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, n2, n3.GetPrevious())
+	assert.True(t, n1.IsLeaf())
+	assert.Equal(t, []*node.Node[int]{n3}, n2.GetNexts())
+}
+
+func TestNode_SetParent_WhenParentIsDescendant_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	root := node.New(0).WithID(0)
+	n1 := node.New(1).WithID(1)
+	n2 := node.New(2).WithID(2)
+	root.AddNext(n1)
+	n1.AddNext(n2)
+
+	// Act
+	okSelf := n1.SetParent(n1)
+	okDescendant := n1.SetParent(n2)
+
+	// Assert
+	assert.False(t, okSelf)
+	assert.False(t, okDescendant)
+	assert.Equal(t, root, n1.GetPrevious())
+	assert.Equal(t, n1, n2.GetPrevious())
+}
+
+func TestNode_SetParentAt_WhenSameParent_ShouldReorder(t *testing.T) {
+	// Arrange
+	root := node.New(0).WithID(0)
+	n1 := node.New(1).WithID(1)
+	n2 := node.New(2).WithID(2)
+	n3 := node.New(3).WithID(3)
+	root.AddNext(n1)
+	root.AddNext(n2)
+	root.AddNext(n3)
+
+	// Act
+	ok := n3.SetParentAt(root, 0)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []*node.Node[int]{n3, n1, n2}, root.GetNexts())
+}
+
+func TestNode_SetParentAt_WhenIndexIsOutOfRange_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	root := node.New(0).WithID(0)
+	n1 := node.New(1).WithID(1)
+	n2 := node.New(2).WithID(2)
+	root.AddNext(n1)
+
+	// Act
+	ok := n2.SetParentAt(root, 2)
+
+	// Assert
+	assert.False(t, ok)
+	assert.True(t, n2.IsRoot())
+	assert.Equal(t, 1, len(root.GetNexts()))
+}
+
+func buildOrderedNode() (*node.Node[int], []*node.Node[int]) {
+	root := node.New(0).WithID(0)
+	nexts := []*node.Node[int]{
+		node.New(3).WithID(1),
+		node.New(1).WithID(2),
+		node.New(2).WithID(3),
+	}
+
+	for _, next := range nexts {
+		root.AddNext(next)
+	}
+
+	return root, nexts
+}
+
+func nextIDs(n *node.Node[int]) []int {
+	var ids []int
+	for _, next := range n.GetNexts() {
+		ids = append(ids, next.GetID())
+	}
+
+	return ids
+}
+
+func TestNode_InsertNextAt_WhenIndexIsValid_ShouldInsert(t *testing.T) {
+	// Arrange
+	root, _ := buildOrderedNode()
+	inserted := node.New(4).WithID(4)
+
+	// Act
+	ok := root.InsertNextAt(1, inserted)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 4, 2, 3}, nextIDs(root))
+	assert.Equal(t, root, inserted.GetPrevious())
+}
+
+func TestNode_InsertNextAt_WhenIndexIsInvalid_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	root, _ := buildOrderedNode()
+
+	// Act
+	okNegative := root.InsertNextAt(-1, node.New(4).WithID(4))
+	okAfterEnd := root.InsertNextAt(4, node.New(4).WithID(4))
+
+	// Assert
+	assert.False(t, okNegative)
+	assert.False(t, okAfterEnd)
+	assert.Equal(t, []int{1, 2, 3}, nextIDs(root))
+}
+
+func TestNode_InsertBefore_WhenSiblingExists_ShouldInsert(t *testing.T) {
+	// Arrange
+	root, _ := buildOrderedNode()
+
+	// Act
+	ok := root.InsertBefore(1, node.New(4).WithID(4))
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []int{4, 1, 2, 3}, nextIDs(root))
+}
+
+func TestNode_InsertAfter_WhenSiblingExists_ShouldInsert(t *testing.T) {
+	// Arrange
+	root, _ := buildOrderedNode()
+
+	// Act
+	ok := root.InsertAfter(3, node.New(4).WithID(4))
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []int{1, 2, 3, 4}, nextIDs(root))
+}
+
+func TestNode_InsertAfter_WhenNodeIsAlreadyNext_ShouldReorder(t *testing.T) {
+	// Arrange
+	root, nexts := buildOrderedNode()
+
+	// Act
+	ok := root.InsertAfter(2, nexts[0])
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []int{2, 1, 3}, nextIDs(root))
+}
+
+func TestNode_InsertBefore_WhenSiblingDoesNotExist_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	root, _ := buildOrderedNode()
+	inserted := node.New(4).WithID(4)
+
+	// Act
+	ok := root.InsertBefore(42, inserted)
+
+	// Assert
+	assert.False(t, ok)
+	assert.True(t, inserted.IsRoot())
+}
+
+func TestNode_SwapSiblings_WhenBothExist_ShouldSwap(t *testing.T) {
+	// Arrange
+	root, _ := buildOrderedNode()
+
+	// Act
+	ok := root.SwapSiblings(1, 3)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []int{3, 2, 1}, nextIDs(root))
+}
+
+func TestNode_SwapSiblings_WhenOneDoesNotExist_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	root, _ := buildOrderedNode()
+
+	// Act
+	ok := root.SwapSiblings(1, 42)
+
+	// Assert
+	assert.False(t, ok)
+	assert.Equal(t, []int{1, 2, 3}, nextIDs(root))
+}
+
+func TestNode_SortChildren_ShouldSortOnlyNexts(t *testing.T) {
+	// Arrange
+	root, nexts := buildOrderedNode()
+	nexts[0].AddNext(node.New(9).WithID(5))
+	nexts[0].AddNext(node.New(8).WithID(6))
+
+	// Act
+	root.SortChildren(func(a, b int) bool {
+		return a < b
+	})
+
+	// Assert
+	assert.Equal(t, []int{2, 3, 1}, nextIDs(root))
+	assert.Equal(t, []int{5, 6}, nextIDs(nexts[0]))
+}
+
+func TestNode_SortChildren_WhenDataIsEqual_ShouldKeepOrder(t *testing.T) {
+	// Arrange
+	root := node.New(0).WithID(0)
+	root.AddNext(node.New(1).WithID(1))
+	root.AddNext(node.New(0).WithID(2))
+	root.AddNext(node.New(1).WithID(3))
+
+	// Act
+	root.SortChildren(func(a, b int) bool {
+		return a < b
+	})
+
+	// Assert
+	assert.Equal(t, []int{2, 1, 3}, nextIDs(root))
+}
+
+func TestNode_SortDeep_ShouldSortAllSubNodes(t *testing.T) {
+	// Arrange
+	root, nexts := buildOrderedNode()
+	nexts[0].AddNext(node.New(9).WithID(5))
+	nexts[0].AddNext(node.New(8).WithID(6))
+
+	// Act
+	root.SortDeep(func(a, b int) bool {
+		return a < b
+	})
+
+	// Assert
+	assert.Equal(t, []int{2, 3, 1}, nextIDs(root))
+	assert.Equal(t, []int{6, 5}, nextIDs(nexts[0]))
+}
+
+const millionDeep = 1_000_000
+
+func buildChain(depth int) (*node.Node[int], *node.Node[int]) {
+	root := node.New(0).WithID(0)
+
+	current := root
+	for i := 1; i < depth; i++ {
+		next := node.New(i).WithID(i)
+		current.AddNext(next)
+		current = next
+	}
+
+	return root, current
+}
+
+func TestNode_WhenTreeIsMillionDeep_ShouldNotOverflowStack(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping million-deep tree in short mode")
+	}
+
+	// Arrange
+	root, leaf := buildChain(millionDeep)
+
+	// Act
+	structure := root.GetStructure()
+	filtered, ok := root.Filter(func(obj int) bool {
+		return true
+	})
+	backtracked := leaf.Backtrack()
+
+	visited := 0
+	for range root.PostOrder() {
+		visited++
+	}
+	root.Walk(func(n *node.Node[int], depth int) node.WalkAction {
+		visited++
+		return node.Continue
+	})
+	root.SortDeep(func(a, b int) bool {
+		return a < b
+	})
+
+	// Assert
+	assert.Equal(t, millionDeep, len(structure))
+	assert.True(t, ok)
+	assert.Equal(t, millionDeep, len(filtered.GetStructure()))
+	assert.Equal(t, millionDeep, len(backtracked))
+	assert.Equal(t, 2*millionDeep, visited)
+}
@@ -0,0 +1,109 @@
+package node
+
+// Ancestors retrieves the nodes from the parent of node to root.
+func (n *Node[T]) Ancestors() []*Node[T] {
+	var ancestors []*Node[T]
+	for current := n.previous; current != nil; current = current.previous {
+		ancestors = append(ancestors, current)
+	}
+
+	return ancestors
+}
+
+// Descendants retrieves the sub-nodes of node in pre-order.
+func (n *Node[T]) Descendants() []*Node[T] {
+	var descendants []*Node[T]
+	for current := range n.PreOrder() {
+		if current != n {
+			descendants = append(descendants, current)
+		}
+	}
+
+	return descendants
+}
+
+// Siblings retrieves the other nexts of the parent of node.
+func (n *Node[T]) Siblings() []*Node[T] {
+	if n.previous == nil {
+		return nil
+	}
+
+	var siblings []*Node[T]
+	for _, next := range n.previous.nexts {
+		if next != n {
+			siblings = append(siblings, next)
+		}
+	}
+
+	return siblings
+}
+
+// NextSibling retrieves the node after node in the nexts of its parent.
+func (n *Node[T]) NextSibling() (*Node[T], bool) {
+	if n.previous == nil {
+		return nil, false
+	}
+
+	index := n.previous.indexOf(n)
+	if index < 0 || index+1 >= len(n.previous.nexts) {
+		return nil, false
+	}
+
+	return n.previous.nexts[index+1], true
+}
+
+// PrevSibling retrieves the node before node in the nexts of its parent.
+func (n *Node[T]) PrevSibling() (*Node[T], bool) {
+	if n.previous == nil {
+		return nil, false
+	}
+
+	index := n.previous.indexOf(n)
+	if index <= 0 {
+		return nil, false
+	}
+
+	return n.previous.nexts[index-1], true
+}
+
+// Leaves retrieves the leaves of the subtree of node in pre-order.
+func (n *Node[T]) Leaves() []*Node[T] {
+	var leaves []*Node[T]
+	for current := range n.PreOrder() {
+		if current.IsLeaf() {
+			leaves = append(leaves, current)
+		}
+	}
+
+	return leaves
+}
+
+// NodesAtDepth retrieves, from left to right, the sub-nodes at a depth from node.
+func (n *Node[T]) NodesAtDepth(depth int) []*Node[T] {
+	var nodes []*Node[T]
+
+	n.Walk(func(current *Node[T], currentDepth int) WalkAction {
+		if currentDepth < depth {
+			return Continue
+		}
+
+		if currentDepth == depth {
+			nodes = append(nodes, current)
+		}
+
+		return SkipChildren
+	})
+
+	return nodes
+}
+
+// IsAncestorOf retrieves info if node is in the path from other to root, not being other itself.
+func (n *Node[T]) IsAncestorOf(other *Node[T]) bool {
+	for current := other.previous; current != nil; current = current.previous {
+		if current == n {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,123 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func nodeIDs(nodes []*node.Node[int]) []int {
+	var ids []int
+	for _, n := range nodes {
+		ids = append(ids, n.GetID())
+	}
+
+	return ids
+}
+
+func TestNode_Ancestors_ShouldRetrieveFromParentToRoot(t *testing.T) {
+	// Arrange
+	root := buildTraversalNode()
+	sut := root.GetNexts()[0].GetNexts()[1]
+
+	// Act
+	ancestors := sut.Ancestors()
+
+	// Assert
+	assert.Equal(t, []int{1, 0}, nodeIDs(ancestors))
+	assert.Nil(t, root.Ancestors())
+}
+
+func TestNode_Descendants_ShouldRetrieveSubNodesInPreOrder(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	descendants := sut.Descendants()
+
+	// Assert
+	assert.Equal(t, []int{1, 3, 4, 2, 5}, nodeIDs(descendants))
+	assert.Nil(t, sut.GetNexts()[1].GetNexts()[0].Descendants())
+}
+
+func TestNode_Siblings_ShouldRetrieveOtherNexts(t *testing.T) {
+	// Arrange
+	root := node.New(0).WithID(0)
+	for i := 1; i <= 3; i++ {
+		root.AddNext(node.New(i).WithID(i))
+	}
+	sut := root.GetNexts()[1]
+
+	// Act
+	siblings := sut.Siblings()
+
+	// Assert
+	assert.Equal(t, []int{1, 3}, nodeIDs(siblings))
+	assert.Nil(t, root.Siblings())
+}
+
+func TestNode_NextSibling_WhenSiblingsExist_ShouldRetrieveAdjacentNodes(t *testing.T) {
+	// Arrange
+	root := node.New(0).WithID(0)
+	for i := 1; i <= 3; i++ {
+		root.AddNext(node.New(i).WithID(i))
+	}
+	first, middle, last := root.GetNexts()[0], root.GetNexts()[1], root.GetNexts()[2]
+
+	// Act
+	next, hasNext := middle.NextSibling()
+	prev, hasPrev := middle.PrevSibling()
+	_, lastHasNext := last.NextSibling()
+	_, firstHasPrev := first.PrevSibling()
+	_, rootHasNext := root.NextSibling()
+	_, rootHasPrev := root.PrevSibling()
+
+	// Assert
+	assert.True(t, hasNext)
+	assert.Equal(t, 3, next.GetID())
+	assert.True(t, hasPrev)
+	assert.Equal(t, 1, prev.GetID())
+	assert.False(t, lastHasNext)
+	assert.False(t, firstHasPrev)
+	assert.False(t, rootHasNext)
+	assert.False(t, rootHasPrev)
+}
+
+func TestNode_Leaves_ShouldRetrieveLeavesInPreOrder(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	leaves := sut.Leaves()
+
+	// Assert
+	assert.Equal(t, []int{3, 4, 5}, nodeIDs(leaves))
+}
+
+func TestNode_NodesAtDepth_ShouldRetrieveLevelFromNode(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act & Assert
+	assert.Equal(t, []int{0}, nodeIDs(sut.NodesAtDepth(0)))
+	assert.Equal(t, []int{1, 2}, nodeIDs(sut.NodesAtDepth(1)))
+	assert.Equal(t, []int{3, 4, 5}, nodeIDs(sut.NodesAtDepth(2)))
+	assert.Nil(t, sut.NodesAtDepth(3))
+	assert.Equal(t, []int{5}, nodeIDs(sut.GetNexts()[1].NodesAtDepth(1)))
+}
+
+func TestNode_IsAncestorOf_ShouldBeStrict(t *testing.T) {
+	// Arrange
+	root := buildTraversalNode()
+	n1 := root.GetNexts()[0]
+	n2 := root.GetNexts()[1]
+	n3 := n1.GetNexts()[0]
+
+	// Act & Assert
+	assert.True(t, root.IsAncestorOf(n3))
+	assert.True(t, n1.IsAncestorOf(n3))
+	assert.False(t, n2.IsAncestorOf(n3))
+	assert.False(t, n3.IsAncestorOf(n1))
+	assert.False(t, n1.IsAncestorOf(n1))
+}
@@ -0,0 +1,76 @@
+package node
+
+import "iter"
+
+// Order defines the order nodes are visited on a search.
+type Order int
+
+const (
+	// DepthFirst visits parents before nexts, finishing a branch before the next one.
+	DepthFirst Order = iota
+	// BreadthFirst visits level by level, so matches closer to the start are found first.
+	BreadthFirst
+)
+
+// Find retrieves the first node, node included, that respects a rule.
+func (n *Node[T]) Find(predicate func(n *Node[T]) bool, order Order) (*Node[T], bool) {
+	for current := range n.inOrder(order) {
+		if predicate(current) {
+			return current, true
+		}
+	}
+
+	return nil, false
+}
+
+// FindAll retrieves all nodes, node included, that respect a rule.
+func (n *Node[T]) FindAll(predicate func(n *Node[T]) bool, order Order) []*Node[T] {
+	var found []*Node[T]
+	for current := range n.inOrder(order) {
+		if predicate(current) {
+			found = append(found, current)
+		}
+	}
+
+	return found
+}
+
+// FindByData retrieves the first node, n included, with data.
+func FindByData[T comparable](n *Node[T], data T, order Order) (*Node[T], bool) {
+	return n.Find(func(current *Node[T]) bool {
+		return current.data == data
+	}, order)
+}
+
+// Any retrieves info if at least one node, node included, respects a rule.
+func (n *Node[T]) Any(predicate func(n *Node[T]) bool) bool {
+	_, found := n.Find(predicate, DepthFirst)
+	return found
+}
+
+// All retrieves info if every node, node included, respects a rule.
+func (n *Node[T]) All(predicate func(n *Node[T]) bool) bool {
+	return !n.Any(func(current *Node[T]) bool {
+		return !predicate(current)
+	})
+}
+
+// Count retrieves the number of nodes, node included, that respect a rule.
+func (n *Node[T]) Count(predicate func(n *Node[T]) bool) int {
+	count := 0
+	for current := range n.PreOrder() {
+		if predicate(current) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (n *Node[T]) inOrder(order Order) iter.Seq[*Node[T]] {
+	if order == BreadthFirst {
+		return n.LevelOrder()
+	}
+
+	return n.PreOrder()
+}
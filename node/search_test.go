@@ -0,0 +1,99 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/stretchr/testify/assert"
+)
+
+// buildSearchNode builds a node where data 7 is deep on the first branch and shallow on the second.
+func buildSearchNode() *node.Node[int] {
+	root := node.New(0).WithID(0)
+	n1 := node.New(1).WithID(1)
+	n2 := node.New(2).WithID(2)
+	root.AddNext(n1)
+	root.AddNext(n2)
+	n3 := node.New(3).WithID(3)
+	n1.AddNext(n3)
+	n3.AddNext(node.New(7).WithID(6))
+	n2.AddNext(node.New(4).WithID(4))
+	n2.AddNext(node.New(7).WithID(5))
+
+	return root
+}
+
+func isSeven(n *node.Node[int]) bool {
+	return n.GetData() == 7
+}
+
+func TestNode_Find_WhenOrderChanges_ShouldRetrieveDifferentMatches(t *testing.T) {
+	// Arrange
+	sut := buildSearchNode()
+
+	// Act
+	depthFirst, foundDepthFirst := sut.Find(isSeven, node.DepthFirst)
+	breadthFirst, foundBreadthFirst := sut.Find(isSeven, node.BreadthFirst)
+
+	// Assert
+	assert.True(t, foundDepthFirst)
+	assert.Equal(t, 6, depthFirst.GetID())
+	assert.True(t, foundBreadthFirst)
+	assert.Equal(t, 5, breadthFirst.GetID())
+}
+
+func TestNode_Find_WhenNothingMatches_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	sut := buildSearchNode()
+
+	// Act
+	n, found := sut.Find(func(n *node.Node[int]) bool {
+		return n.GetData() == 42
+	}, node.BreadthFirst)
+
+	// Assert
+	assert.Nil(t, n)
+	assert.False(t, found)
+}
+
+func TestNode_FindAll_ShouldRetrieveInOrder(t *testing.T) {
+	// Arrange
+	sut := buildSearchNode()
+
+	// Act
+	depthFirst := sut.FindAll(isSeven, node.DepthFirst)
+	breadthFirst := sut.FindAll(isSeven, node.BreadthFirst)
+
+	// Assert
+	assert.Equal(t, []int{6, 5}, nodeIDs(depthFirst))
+	assert.Equal(t, []int{5, 6}, nodeIDs(breadthFirst))
+}
+
+func TestFindByData_ShouldCompareData(t *testing.T) {
+	// Arrange
+	sut := buildSearchNode()
+
+	// Act
+	found, ok := node.FindByData(sut, 4, node.DepthFirst)
+	_, notOk := node.FindByData(sut, 42, node.DepthFirst)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, 4, found.GetID())
+	assert.False(t, notOk)
+}
+
+func TestNode_Any_WhenSomeNodesMatch_ShouldAggregateRule(t *testing.T) {
+	// Arrange
+	sut := buildSearchNode()
+	isPositive := func(n *node.Node[int]) bool {
+		return n.GetData() >= 0
+	}
+
+	// Act & Assert
+	assert.True(t, sut.Any(isSeven))
+	assert.False(t, sut.All(isSeven))
+	assert.True(t, sut.All(isPositive))
+	assert.Equal(t, 2, sut.Count(isSeven))
+	assert.Equal(t, 7, sut.Count(isPositive))
+}
@@ -0,0 +1,85 @@
+package node
+
+// MapContext describes where the node being mapped is.
+type MapContext[T any] struct {
+	// Depth is the number of edges from the node Map started on.
+	Depth int
+	// Parent is the original parent, nil for the node Map started on.
+	Parent *Node[T]
+}
+
+// Map creates a copy of node and sub-nodes with data converted by fn, keeping IDs and order.
+func Map[T any, U any](n *Node[T], fn func(data T) U) *Node[U] {
+	return MapWithContext(n, func(data T, _ MapContext[T]) U {
+		return fn(data)
+	})
+}
+
+// MapWithContext creates a copy of node and sub-nodes with data converted by fn, keeping IDs and order.
+// Parents are converted before their nexts.
+func MapWithContext[T any, U any](n *Node[T], fn func(data T, ctx MapContext[T]) U) *Node[U] {
+	type pair struct {
+		original *Node[T]
+		mapped   *Node[U]
+		depth    int
+	}
+
+	mapped := New(fn(n.data, MapContext[T]{})).WithID(n.id)
+
+	stack := []pair{{original: n, mapped: mapped}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		ctx := MapContext[T]{Depth: current.depth + 1, Parent: current.original}
+		for _, next := range current.original.nexts {
+			current.mapped.AddNext(New(fn(next.data, ctx)).WithID(next.id))
+		}
+
+		for i := len(current.original.nexts) - 1; i >= 0; i-- {
+			stack = append(stack, pair{original: current.original.nexts[i], mapped: current.mapped.nexts[i], depth: ctx.Depth})
+		}
+	}
+
+	return mapped
+}
+
+// Fold accumulates node and sub-nodes in pre-order.
+func Fold[T any, A any](n *Node[T], initial A, fn func(acc A, n *Node[T]) A) A {
+	acc := initial
+	for current := range n.PreOrder() {
+		acc = fn(acc, current)
+	}
+
+	return acc
+}
+
+// Aggregate creates a copy of node and sub-nodes holding a value computed bottom-up, keeping IDs and order.
+// Leaves use leafFn and other nodes combine their data with the values of their nexts.
+func Aggregate[T any, A any](n *Node[T], leafFn func(data T) A, combine func(data T, nexts []A) A) *Node[A] {
+	var results []*Node[A]
+
+	for current := range n.PostOrder() {
+		if current.IsLeaf() {
+			results = append(results, New(leafFn(current.data)).WithID(current.id))
+			continue
+		}
+
+		nexts := results[len(results)-len(current.nexts):]
+		results = results[:len(results)-len(current.nexts)]
+
+		values := make([]A, len(nexts))
+		for i, next := range nexts {
+			values[i] = next.data
+		}
+
+		aggregated := New(combine(current.data, values)).WithID(current.id)
+		for _, next := range nexts {
+			aggregated.AddNext(next)
+		}
+
+		results = append(results, aggregated)
+	}
+
+	return results[0]
+}
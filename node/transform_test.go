@@ -0,0 +1,140 @@
+package node_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap_ShouldConvertDataKeepingIDsAndOrder(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	mapped := node.Map(sut, strconv.Itoa)
+
+	// Assert
+	assert.Equal(t, "*node.Node[string]", fmt.Sprintf("%T", mapped))
+	assert.Equal(t, sut.GetStructure(), mapped.GetStructure())
+	for original := range sut.PreOrder() {
+		found, ok := mapped.Find(func(n *node.Node[string]) bool {
+			return n.GetID() == original.GetID()
+		}, node.DepthFirst)
+		assert.True(t, ok)
+		assert.Equal(t, strconv.Itoa(original.GetData()), found.GetData())
+	}
+	assert.Equal(t, 0, sut.GetData())
+}
+
+func TestMapWithContext_ShouldSeeDepthAndParent(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	mapped := node.MapWithContext(sut, func(data int, ctx node.MapContext[int]) string {
+		if ctx.Parent == nil {
+			return fmt.Sprintf("%d@%d", data, ctx.Depth)
+		}
+		return fmt.Sprintf("%d@%d<%d", data, ctx.Depth, ctx.Parent.GetData())
+	})
+
+	// Assert
+	var labels []string
+	for n := range mapped.PreOrder() {
+		labels = append(labels, n.GetData())
+	}
+	assert.Equal(t, []string{"0@0", "1@1<0", "3@2<1", "4@2<1", "2@1<0", "5@2<2"}, labels)
+}
+
+func TestMap_WhenNodeIsNotRoot_ShouldMapOnlySubtree(t *testing.T) {
+	// Arrange
+	root := buildTraversalNode()
+	sut := root.GetNexts()[0]
+
+	// Act
+	mapped := node.Map(sut, func(data int) float64 {
+		return float64(data) / 2
+	})
+
+	// Assert
+	assert.True(t, mapped.IsRoot())
+	assert.Equal(t, 3, mapped.Size())
+	assert.Equal(t, 1.5, mapped.GetNexts()[0].GetData())
+}
+
+func sum(data int, nexts []int) int {
+	total := data
+	for _, next := range nexts {
+		total += next
+	}
+
+	return total
+}
+
+func identity(data int) int {
+	return data
+}
+
+func TestFold_ShouldAccumulateInPreOrder(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	visited := node.Fold(sut, "", func(acc string, n *node.Node[int]) string {
+		return acc + strconv.Itoa(n.GetID())
+	})
+	total := node.Fold(sut, 0, func(acc int, n *node.Node[int]) int {
+		return acc + n.GetData()
+	})
+
+	// Assert
+	assert.Equal(t, "013425", visited)
+	assert.Equal(t, 15, total)
+}
+
+func TestAggregate_ShouldComputeBottomUp(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	aggregated := node.Aggregate(sut, identity, sum)
+
+	// Assert
+	assert.Equal(t, sut.GetStructure(), aggregated.GetStructure())
+	assert.Equal(t, 15, aggregated.GetData())
+	assert.Equal(t, 8, aggregated.GetNexts()[0].GetData())
+	assert.Equal(t, 7, aggregated.GetNexts()[1].GetData())
+	assert.Equal(t, 3, aggregated.GetNexts()[0].GetNexts()[0].GetData())
+}
+
+func TestAggregate_WhenNodeIsLeaf_ShouldUseLeafFn(t *testing.T) {
+	// Arrange
+	sut := node.New(2).WithID(9)
+
+	// Act
+	aggregated := node.Aggregate(sut, func(data int) []int {
+		return []int{data}
+	}, func(data int, nexts [][]int) []int {
+		return nil
+	})
+
+	// Assert
+	assert.Equal(t, []int{2}, aggregated.GetData())
+	assert.Equal(t, 9, aggregated.GetID())
+}
+
+func TestAggregate_ShouldCombineNextsInOrder(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	aggregated := node.Aggregate(sut, strconv.Itoa, func(data int, nexts []string) string {
+		return fmt.Sprintf("%d%v", data, nexts)
+	})
+
+	// Assert
+	assert.Equal(t, "0[1[3 4] 2[5]]", aggregated.GetData())
+}
@@ -0,0 +1,97 @@
+package node
+
+import "iter"
+
+type frame[T any] struct {
+	node  *Node[T]
+	depth int
+	next  int
+}
+
+// PreOrder retrieves an iterator over node and sub-nodes, visiting parents before nexts.
+func (n *Node[T]) PreOrder() iter.Seq[*Node[T]] {
+	return withoutDepth(n.PreOrderWithDepth())
+}
+
+// PostOrder retrieves an iterator over node and sub-nodes, visiting nexts before parents.
+func (n *Node[T]) PostOrder() iter.Seq[*Node[T]] {
+	return withoutDepth(n.PostOrderWithDepth())
+}
+
+// LevelOrder retrieves an iterator over node and sub-nodes, visiting level by level.
+func (n *Node[T]) LevelOrder() iter.Seq[*Node[T]] {
+	return withoutDepth(n.LevelOrderWithDepth())
+}
+
+// PreOrderWithDepth retrieves an iterator over node and sub-nodes with their depth from node,
+// visiting parents before nexts.
+func (n *Node[T]) PreOrderWithDepth() iter.Seq2[*Node[T], int] {
+	return func(yield func(*Node[T], int) bool) {
+		stack := []frame[T]{{node: n}}
+		for len(stack) > 0 {
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+
+			if !yield(current.node, current.depth) {
+				return
+			}
+
+			for i := len(current.node.nexts) - 1; i >= 0; i-- {
+				stack = append(stack, frame[T]{node: current.node.nexts[i], depth: current.depth + 1})
+			}
+		}
+	}
+}
+
+// PostOrderWithDepth retrieves an iterator over node and sub-nodes with their depth from node,
+// visiting nexts before parents.
+func (n *Node[T]) PostOrderWithDepth() iter.Seq2[*Node[T], int] {
+	return func(yield func(*Node[T], int) bool) {
+		stack := []frame[T]{{node: n}}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+
+			if top.next < len(top.node.nexts) {
+				next := top.node.nexts[top.next]
+				top.next++
+				stack = append(stack, frame[T]{node: next, depth: top.depth + 1})
+				continue
+			}
+
+			stack = stack[:len(stack)-1]
+			if !yield(top.node, top.depth) {
+				return
+			}
+		}
+	}
+}
+
+// LevelOrderWithDepth retrieves an iterator over node and sub-nodes with their depth from node,
+// visiting level by level.
+func (n *Node[T]) LevelOrderWithDepth() iter.Seq2[*Node[T], int] {
+	return func(yield func(*Node[T], int) bool) {
+		queue := []frame[T]{{node: n}}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+
+			if !yield(current.node, current.depth) {
+				return
+			}
+
+			for _, next := range current.node.nexts {
+				queue = append(queue, frame[T]{node: next, depth: current.depth + 1})
+			}
+		}
+	}
+}
+
+func withoutDepth[T any](seq iter.Seq2[*Node[T], int]) iter.Seq[*Node[T]] {
+	return func(yield func(*Node[T]) bool) {
+		for n := range seq {
+			if !yield(n) {
+				return
+			}
+		}
+	}
+}
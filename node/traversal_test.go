@@ -0,0 +1,128 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/stretchr/testify/assert"
+)
+
+// buildTraversalNode builds 0 as root, 1 and 2 under 0, 3 and 4 under 1 and 5 under 2.
+func buildTraversalNode() *node.Node[int] {
+	n0 := node.New(0).WithID(0)
+	n1 := node.New(1).WithID(1)
+	n2 := node.New(2).WithID(2)
+
+	n0.AddNext(n1)
+	n0.AddNext(n2)
+	n1.AddNext(node.New(3).WithID(3))
+	n1.AddNext(node.New(4).WithID(4))
+	n2.AddNext(node.New(5).WithID(5))
+
+	return n0
+}
+
+func TestNode_PreOrder_ShouldVisitParentsBeforeNexts(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	var ids []int
+	for n := range sut.PreOrder() {
+		ids = append(ids, n.GetID())
+	}
+
+	// Assert
+	assert.Equal(t, []int{0, 1, 3, 4, 2, 5}, ids)
+}
+
+func TestNode_PostOrder_ShouldVisitNextsBeforeParents(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	var ids []int
+	for n := range sut.PostOrder() {
+		ids = append(ids, n.GetID())
+	}
+
+	// Assert
+	assert.Equal(t, []int{3, 4, 1, 5, 2, 0}, ids)
+}
+
+func TestNode_LevelOrder_ShouldVisitLevelByLevel(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	var ids []int
+	for n := range sut.LevelOrder() {
+		ids = append(ids, n.GetID())
+	}
+
+	// Assert
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, ids)
+}
+
+func TestNode_WithDepth_ShouldRetrieveDepthFromNode(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+	expected := map[int]int{0: 0, 1: 1, 2: 1, 3: 2, 4: 2, 5: 2}
+
+	iterators := map[string]func(yield func(*node.Node[int], int) bool){
+		"pre":   sut.PreOrderWithDepth(),
+		"post":  sut.PostOrderWithDepth(),
+		"level": sut.LevelOrderWithDepth(),
+	}
+
+	for name, seq := range iterators {
+		// Act
+		depths := make(map[int]int)
+		for n, depth := range seq {
+			depths[n.GetID()] = depth
+		}
+
+		// Assert
+		assert.Equal(t, expected, depths, name)
+	}
+}
+
+func TestNode_PreOrder_WhenBreak_ShouldStop(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	iterators := map[string]func(yield func(*node.Node[int]) bool){
+		"pre":   sut.PreOrder(),
+		"post":  sut.PostOrder(),
+		"level": sut.LevelOrder(),
+	}
+
+	for name, seq := range iterators {
+		// Act
+		visited := 0
+		for range seq {
+			visited++
+			if visited == 2 {
+				break
+			}
+		}
+
+		// Assert
+		assert.Equal(t, 2, visited, name)
+	}
+}
+
+func TestNode_PreOrder_WhenStartsOnSubNode_ShouldVisitOnlySubtree(t *testing.T) {
+	// Arrange
+	root := buildTraversalNode()
+	sut := root.GetNexts()[1]
+
+	// Act
+	var ids []int
+	for n, depth := range sut.PreOrderWithDepth() {
+		ids = append(ids, n.GetID(), depth)
+	}
+
+	// Assert
+	assert.Equal(t, []int{2, 0, 5, 1}, ids)
+}
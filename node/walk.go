@@ -0,0 +1,57 @@
+package node
+
+// WalkAction defines how a walk goes on after visiting a node.
+type WalkAction int
+
+const (
+	// Continue visits the nexts of the node.
+	Continue WalkAction = iota
+	// SkipChildren doesn´t visit the nexts of the node.
+	SkipChildren
+	// Stop ends the walk without visiting any other node.
+	Stop
+)
+
+// Walk visits node and sub-nodes, parents before nexts, with their depth from node.
+func (n *Node[T]) Walk(fn func(n *Node[T], depth int) WalkAction) {
+	n.WalkEnterExit(fn, nil)
+}
+
+// WalkEnterExit visits node and sub-nodes calling enter before visiting the nexts
+// and exit after them, exit is not called once enter returns Stop.
+func (n *Node[T]) WalkEnterExit(enter func(n *Node[T], depth int) WalkAction, exit func(n *Node[T], depth int)) {
+	stack := []frame[T]{{node: n}}
+
+	switch enter(n, 0) {
+	case Stop:
+		return
+	case SkipChildren:
+		stack[0].next = len(n.nexts)
+	}
+
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+
+		if top.next >= len(top.node.nexts) {
+			stack = stack[:len(stack)-1]
+			if exit != nil {
+				exit(top.node, top.depth)
+			}
+			continue
+		}
+
+		next := top.node.nexts[top.next]
+		depth := top.depth + 1
+		top.next++
+
+		current := frame[T]{node: next, depth: depth}
+		switch enter(next, depth) {
+		case Stop:
+			return
+		case SkipChildren:
+			current.next = len(next.nexts)
+		}
+
+		stack = append(stack, current)
+	}
+}
@@ -0,0 +1,115 @@
+package node_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNode_Walk_WhenContinue_ShouldVisitAllWithDepth(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	var visited []string
+	sut.Walk(func(n *node.Node[int], depth int) node.WalkAction {
+		visited = append(visited, fmt.Sprintf("%d:%d", n.GetID(), depth))
+		return node.Continue
+	})
+
+	// Assert
+	assert.Equal(t, []string{"0:0", "1:1", "3:2", "4:2", "2:1", "5:2"}, visited)
+}
+
+func TestNode_Walk_WhenSkipChildren_ShouldNotVisitSubNodes(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	var ids []int
+	sut.Walk(func(n *node.Node[int], depth int) node.WalkAction {
+		ids = append(ids, n.GetID())
+		if n.GetID() == 1 {
+			return node.SkipChildren
+		}
+		return node.Continue
+	})
+
+	// Assert
+	assert.Equal(t, []int{0, 1, 2, 5}, ids)
+}
+
+func TestNode_Walk_WhenRootSkipChildren_ShouldVisitOnlyRoot(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	var ids []int
+	sut.Walk(func(n *node.Node[int], depth int) node.WalkAction {
+		ids = append(ids, n.GetID())
+		return node.SkipChildren
+	})
+
+	// Assert
+	assert.Equal(t, []int{0}, ids)
+}
+
+func TestNode_Walk_WhenStop_ShouldNotVisitAnyOther(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+
+	// Act
+	var ids []int
+	sut.Walk(func(n *node.Node[int], depth int) node.WalkAction {
+		ids = append(ids, n.GetID())
+		if n.GetID() == 3 {
+			return node.Stop
+		}
+		return node.Continue
+	})
+
+	// Assert
+	assert.Equal(t, []int{0, 1, 3}, ids)
+}
+
+func TestNode_WalkEnterExit_ShouldBuildNestedOutput(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+	var sb strings.Builder
+
+	// Act
+	sut.WalkEnterExit(func(n *node.Node[int], depth int) node.WalkAction {
+		sb.WriteString(fmt.Sprintf("(%d", n.GetID()))
+		if n.GetID() == 2 {
+			return node.SkipChildren
+		}
+		return node.Continue
+	}, func(n *node.Node[int], depth int) {
+		sb.WriteString(")")
+	})
+
+	// Assert
+	assert.Equal(t, "(0(1(3)(4))(2))", sb.String())
+}
+
+func TestNode_WalkEnterExit_WhenStop_ShouldNotCallExit(t *testing.T) {
+	// Arrange
+	sut := buildTraversalNode()
+	exits := 0
+
+	// Act
+	sut.WalkEnterExit(func(n *node.Node[int], depth int) node.WalkAction {
+		if n.GetID() == 4 {
+			return node.Stop
+		}
+		return node.Continue
+	}, func(n *node.Node[int], depth int) {
+		exits++
+	})
+
+	// Assert
+	assert.Equal(t, 1, exits)
+}
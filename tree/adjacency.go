@@ -0,0 +1,253 @@
+package tree
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+)
+
+// AdjacencyError reports every problem found while building a Tree from adjacency rows.
+type AdjacencyError struct {
+	// Duplicates are IDs used by more than one row.
+	Duplicates []int
+	// Orphans are IDs of rows whose parent is not in the rows.
+	Orphans []int
+	// Roots are IDs of rows without parent, filled when there is more than one.
+	Roots []int
+	// Cycles are chains of IDs where each row is the parent of the next one and the last is the parent of the first.
+	Cycles [][]int
+	// NoRoot is true when no row is missing a parent.
+	NoRoot bool
+}
+
+// Error retrieves the error message.
+func (e *AdjacencyError) Error() string {
+	var problems []string
+
+	if len(e.Duplicates) > 0 {
+		problems = append(problems, fmt.Sprintf("duplicate ids %v", e.Duplicates))
+	}
+	if len(e.Orphans) > 0 {
+		problems = append(problems, fmt.Sprintf("orphan ids %v", e.Orphans))
+	}
+	if len(e.Roots) > 0 {
+		problems = append(problems, fmt.Sprintf("multiple roots %v", e.Roots))
+	}
+	if e.NoRoot {
+		problems = append(problems, "no root")
+	}
+	if len(e.Cycles) > 0 {
+		problems = append(problems, fmt.Sprintf("cycles %v", e.Cycles))
+	}
+
+	return "invalid adjacency: " + strings.Join(problems, ", ")
+}
+
+// Is allows errors.Is with ErrDuplicateID, ErrParentNotFound, ErrMultipleRoots, ErrNoRoot and ErrCycle.
+func (e *AdjacencyError) Is(target error) bool {
+	switch target {
+	case ErrDuplicateID:
+		return len(e.Duplicates) > 0
+	case ErrParentNotFound:
+		return len(e.Orphans) > 0
+	case ErrMultipleRoots:
+		return len(e.Roots) > 0
+	case ErrNoRoot:
+		return e.NoRoot
+	case ErrCycle:
+		return len(e.Cycles) > 0
+	default:
+		return false
+	}
+}
+
+// FromAdjacency builds a Tree from rows in any order, idFn retrieves the row ID and parentFn
+// retrieves the parent ID or false for the root. Sub-nodes keep the order of the rows.
+func FromAdjacency[R any](rows []R, idFn func(row R) int, parentFn func(row R) (int, bool), opts ...Option) (*Tree[R], error) {
+	root, err := fromAdjacency(rows, idFn, parentFn, func(row R) R { return row })
+	if err != nil {
+		return nil, err
+	}
+
+	t := New[R](opts...)
+	if root != nil {
+		t.root = root
+		t.indexSubtree(root)
+	}
+
+	return t, nil
+}
+
+func fromAdjacency[R any, T any](rows []R, idFn func(R) int, parentFn func(R) (int, bool), dataFn func(R) T) (*node.Node[T], error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	adjErr := &AdjacencyError{}
+	positions := make(map[int]int, len(rows))
+	duplicated := make(map[int]bool)
+
+	for i, row := range rows {
+		id := idFn(row)
+		if _, exists := positions[id]; exists {
+			if !duplicated[id] {
+				adjErr.Duplicates = append(adjErr.Duplicates, id)
+				duplicated[id] = true
+			}
+			continue
+		}
+		positions[id] = i
+	}
+
+	// parents[i] is the position of the parent of rows[i] or -1.
+	parents := make([]int, len(rows))
+	children := make([][]int, len(rows))
+	var starts, roots []int
+
+	for i, row := range rows {
+		parents[i] = -1
+		if positions[idFn(row)] != i {
+			continue
+		}
+
+		parentID, hasParent := parentFn(row)
+		if !hasParent {
+			roots = append(roots, i)
+			starts = append(starts, i)
+			continue
+		}
+
+		parent, found := positions[parentID]
+		if !found {
+			adjErr.Orphans = append(adjErr.Orphans, idFn(row))
+			starts = append(starts, i)
+			continue
+		}
+
+		parents[i] = parent
+		children[parent] = append(children[parent], i)
+	}
+
+	if len(roots) > 1 {
+		for _, i := range roots {
+			adjErr.Roots = append(adjErr.Roots, idFn(rows[i]))
+		}
+	}
+	adjErr.NoRoot = len(roots) == 0
+
+	adjErr.Cycles = findCycles(rows, idFn, positions, parents, children, starts)
+
+	if len(adjErr.Duplicates) > 0 || len(adjErr.Orphans) > 0 || len(adjErr.Roots) > 0 || adjErr.NoRoot || len(adjErr.Cycles) > 0 {
+		return nil, adjErr
+	}
+
+	nodes := make([]*node.Node[T], len(rows))
+	for i, row := range rows {
+		nodes[i] = node.New(dataFn(row)).WithID(idFn(row))
+	}
+
+	for i := range rows {
+		for _, child := range children[i] {
+			nodes[i].AddNext(nodes[child])
+		}
+	}
+
+	return nodes[roots[0]], nil
+}
+
+// findCycles retrieves the cycles among the rows that can´t be reached from starts.
+func findCycles[R any](rows []R, idFn func(R) int, positions map[int]int, parents []int, children [][]int, starts []int) [][]int {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make([]int, len(rows))
+
+	stack := append([]int(nil), starts...)
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		state[current] = visited
+		stack = append(stack, children[current]...)
+	}
+
+	var cycles [][]int
+	for i, row := range rows {
+		if state[i] != unvisited || positions[idFn(row)] != i {
+			continue
+		}
+
+		var path []int
+		current := i
+		for state[current] == unvisited {
+			state[current] = visiting
+			path = append(path, current)
+			current = parents[current]
+		}
+
+		if state[current] == visiting {
+			var cycle []int
+			for j := len(path) - 1; j >= 0; j-- {
+				cycle = append(cycle, idFn(rows[path[j]]))
+				if path[j] == current {
+					break
+				}
+			}
+			cycles = append(cycles, cycle)
+		}
+
+		for _, p := range path {
+			state[p] = visited
+		}
+	}
+
+	return cycles
+}
+
+// AdjacencyRow is a Tree node flattened with its parent ID and depth.
+type AdjacencyRow[T any] struct {
+	ID        int
+	ParentID  int
+	HasParent bool
+	Depth     int
+	Data      T
+}
+
+// ToAdjacency retrieves Tree nodes in pre-order as adjacency rows.
+func (t *Tree[T]) ToAdjacency() []AdjacencyRow[T] {
+	var rows []AdjacencyRow[T]
+
+	for n, depth := range t.PreOrderWithDepth() {
+		row := AdjacencyRow[T]{ID: n.GetID(), Depth: depth, Data: n.GetData()}
+		if depth > 0 {
+			row.ParentID = n.GetPrevious().GetID()
+			row.HasParent = true
+		}
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// ToMaterializedPaths retrieves, in pre-order, the IDs from root to each node joined by sep.
+func (t *Tree[T]) ToMaterializedPaths(sep string) []string {
+	var paths []string
+	var ancestors []string
+
+	for n, depth := range t.PreOrderWithDepth() {
+		path := strconv.Itoa(n.GetID())
+		if depth > 0 {
+			path = ancestors[depth-1] + sep + path
+		}
+
+		ancestors = append(ancestors[:depth], path)
+		paths = append(paths, path)
+	}
+
+	return paths
+}
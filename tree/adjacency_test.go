@@ -0,0 +1,183 @@
+package tree_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+type row struct {
+	id       int
+	parentID int
+	payload  string
+}
+
+func rowID(r row) int {
+	return r.id
+}
+
+// rowParent treats a negative parentID as root.
+func rowParent(r row) (int, bool) {
+	return r.parentID, r.parentID >= 0
+}
+
+func TestFromAdjacency_WhenRowsAreUnordered_ShouldBuildTree(t *testing.T) {
+	// Arrange
+	rows := []row{
+		{3, 1, "1.3"},
+		{1, 0, "0.1"},
+		{2, 0, "0.2"},
+		{4, 1, "1.4"},
+		{0, -1, "0.0"},
+	}
+
+	// Act
+	tr, err := tree.FromAdjacency(rows, rowID, rowParent)
+
+	// Assert
+	assert.Nil(t, err)
+	root, _ := tr.GetRoot()
+	assert.Equal(t, "0.0", root.GetData().payload)
+	assert.Equal(t, []int{1, 2}, nextIDs(tr, 0))
+	assert.Equal(t, []int{3, 4}, nextIDs(tr, 1))
+	nodes, _ := tr.Backtrack(4)
+	assert.Equal(t, 3, len(nodes))
+}
+
+func TestFromAdjacency_WhenRowsAreEmpty_ShouldReturnEmptyTree(t *testing.T) {
+	// Act
+	tr, err := tree.FromAdjacency(nil, rowID, rowParent)
+
+	// Assert
+	assert.Nil(t, err)
+	_, hasRoot := tr.GetRoot()
+	assert.False(t, hasRoot)
+}
+
+func TestFromAdjacency_WhenRowsHaveProblems_ShouldReportThemAll(t *testing.T) {
+	// Arrange
+	rows := []row{
+		{0, -1, "root"},
+		{1, 0, "child"},
+		{1, 0, "duplicate"},
+		{2, 42, "orphan"},
+		{3, 2, "under orphan"},
+		{4, -1, "second root"},
+		{5, 6, "cycle"},
+		{6, 7, "cycle"},
+		{7, 5, "cycle"},
+		{8, 7, "hanging on cycle"},
+	}
+
+	// Act
+	tr, err := tree.FromAdjacency(rows, rowID, rowParent)
+
+	// Assert
+	assert.Nil(t, tr)
+
+	var adjErr *tree.AdjacencyError
+	assert.True(t, errors.As(err, &adjErr))
+	assert.Equal(t, []int{1}, adjErr.Duplicates)
+	assert.Equal(t, []int{2}, adjErr.Orphans)
+	assert.Equal(t, []int{0, 4}, adjErr.Roots)
+	assert.Equal(t, [][]int{{7, 6, 5}}, adjErr.Cycles)
+	assert.False(t, adjErr.NoRoot)
+
+	assert.ErrorIs(t, err, tree.ErrDuplicateID)
+	assert.ErrorIs(t, err, tree.ErrParentNotFound)
+	assert.ErrorIs(t, err, tree.ErrMultipleRoots)
+	assert.ErrorIs(t, err, tree.ErrCycle)
+	assert.NotErrorIs(t, err, tree.ErrNoRoot)
+	assert.Equal(t, "invalid adjacency: duplicate ids [1], orphan ids [2], multiple roots [0 4], cycles [[7 6 5]]", err.Error())
+}
+
+func TestFromAdjacency_WhenEveryRowHasParent_ShouldReportNoRoot(t *testing.T) {
+	// Arrange
+	rows := []row{
+		{0, 0, "self"},
+	}
+
+	// Act
+	_, err := tree.FromAdjacency(rows, rowID, rowParent)
+
+	// Assert
+	var adjErr *tree.AdjacencyError
+	assert.True(t, errors.As(err, &adjErr))
+	assert.True(t, adjErr.NoRoot)
+	assert.Equal(t, [][]int{{0}}, adjErr.Cycles)
+}
+
+func TestFromAdjacency_WhenOptionsAreSet_ShouldApplyThem(t *testing.T) {
+	// Arrange
+	rows := []row{
+		{0, -1, "root"},
+	}
+
+	// Act
+	tr, err := tree.FromAdjacency(rows, rowID, rowParent, tree.WithJSONFormat(tree.FlatJSON))
+	bytes, _ := tr.MarshalJSON()
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, `[{"id":0,"parent_id":null,"data":{}}]`, string(bytes))
+}
+
+func TestTree_ToAdjacency_WhenThereIsNoRoot_ShouldReturnNil(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+
+	// Act
+	rows := tr.ToAdjacency()
+	paths := tr.ToMaterializedPaths("/")
+
+	// Assert
+	assert.Nil(t, rows)
+	assert.Nil(t, paths)
+}
+
+func TestTree_ToAdjacency_WhenThereIsRoot_ShouldFlattenInPreOrder(t *testing.T) {
+	// Arrange
+	tr := buildJSONTree()
+
+	// Act
+	rows := tr.ToAdjacency()
+
+	// Assert
+	assert.Equal(t, []tree.AdjacencyRow[string]{
+		{ID: 0, Depth: 0, Data: "a"},
+		{ID: 1, ParentID: 0, HasParent: true, Depth: 1, Data: "b"},
+		{ID: 3, ParentID: 1, HasParent: true, Depth: 2, Data: "d"},
+		{ID: 2, ParentID: 0, HasParent: true, Depth: 1, Data: "c"},
+	}, rows)
+}
+
+func TestTree_ToAdjacency_ShouldRoundTripWithFromAdjacency(t *testing.T) {
+	// Arrange
+	tr := buildJSONTree()
+
+	// Act
+	rebuilt, err := tree.FromAdjacency(tr.ToAdjacency(), func(r tree.AdjacencyRow[string]) int {
+		return r.ID
+	}, func(r tree.AdjacencyRow[string]) (int, bool) {
+		return r.ParentID, r.HasParent
+	})
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, tr.ToMaterializedPaths("/"), rebuilt.ToMaterializedPaths("/"))
+}
+
+func TestTree_ToMaterializedPaths_ShouldJoinIDsFromRoot(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	paths := tr.ToMaterializedPaths("/")
+	dotted := tr.ToMaterializedPaths(".")
+
+	// Assert
+	assert.Equal(t, []string{"0", "0/1", "0/1/6", "0/2", "0/2/3", "0/2/4", "0/2/5"}, paths)
+	assert.Equal(t, "0.2.5", dotted[6])
+}
@@ -0,0 +1,34 @@
+package tree
+
+import "github.com/johnfercher/go-tree/node"
+
+// Clone creates a copy of Tree sharing node data.
+func (t *Tree[T]) Clone() *Tree[T] {
+	return Map(t, func(data T) T {
+		return data
+	})
+}
+
+// CloneWith creates a copy of Tree with node data copied by copyFn.
+func (t *Tree[T]) CloneWith(copyFn func(data T) T) *Tree[T] {
+	return Map(t, copyFn)
+}
+
+// Equal retrieves info if both trees have the same shape, IDs and data, with nexts in the same order.
+func Equal[T any](a *Tree[T], b *Tree[T], eq func(a, b T) bool) bool {
+	if a.root == nil || b.root == nil {
+		return a.root == nil && b.root == nil
+	}
+
+	return node.Equal(a.root, b.root, eq)
+}
+
+// EqualUnordered retrieves info if both trees have the same shape, IDs and data, matching nexts by ID,
+// nexts sharing an ID are matched by any pairing that makes them equal.
+func EqualUnordered[T any](a *Tree[T], b *Tree[T], eq func(a, b T) bool) bool {
+	if a.root == nil || b.root == nil {
+		return a.root == nil && b.root == nil
+	}
+
+	return node.EqualUnordered(a.root, b.root, eq)
+}
@@ -0,0 +1,70 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func intEq(a, b int) bool {
+	return a == b
+}
+
+func TestTree_Clone_ShouldCreateIndependentTree(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	cloned := tr.Clone()
+	cloned.Remove(2)
+
+	// Assert
+	_, foundOriginal := tr.Get(2)
+	_, foundCloned := cloned.Get(2)
+	assert.True(t, foundOriginal)
+	assert.False(t, foundCloned)
+}
+
+func TestTree_CloneWith_ShouldCopyData(t *testing.T) {
+	// Arrange
+	tr := tree.New[[]int]()
+	tr.AddRoot(node.New([]int{1, 2}).WithID(0))
+
+	// Act
+	cloned := tr.CloneWith(func(data []int) []int {
+		return append([]int(nil), data...)
+	})
+	root, _ := cloned.GetRoot()
+	root.GetData()[0] = 42
+
+	// Assert
+	original, _ := tr.GetRoot()
+	assert.Equal(t, []int{1, 2}, original.GetData())
+}
+
+func TestEqual_WhenTreesAreEmpty_ShouldCompareRoots(t *testing.T) {
+	// Arrange
+	empty := tree.New[int]()
+	other := tree.New[int]()
+	full := buildMoveTree()
+
+	// Act & Assert
+	assert.True(t, tree.Equal(empty, other, intEq))
+	assert.False(t, tree.Equal(empty, full, intEq))
+	assert.False(t, tree.EqualUnordered(full, empty, intEq))
+	assert.True(t, tree.EqualUnordered(empty, other, intEq))
+}
+
+func TestEqual_WhenTreesAreReordered_ShouldDependOnMode(t *testing.T) {
+	// Arrange
+	a := buildMoveTree()
+	b := buildMoveTree()
+	b.Move(3, 2, tree.AtEnd())
+
+	// Act & Assert
+	assert.True(t, tree.Equal(a, a.Clone(), intEq))
+	assert.False(t, tree.Equal(a, b, intEq))
+	assert.True(t, tree.EqualUnordered(a, b, intEq))
+}
@@ -0,0 +1,448 @@
+package tree
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+)
+
+// ChangeKind defines what happened to a node between two trees.
+type ChangeKind int
+
+const (
+	// Added nodes exist only in the new tree.
+	Added ChangeKind = iota
+	// Removed nodes exist only in the old tree.
+	Removed
+	// Moved nodes have a different parent in the new tree.
+	Moved
+	// Reordered nodes keep their parent but changed position among siblings that also kept it.
+	Reordered
+	// Modified nodes have different data in the new tree.
+	Modified
+)
+
+// String retrieves the name of the kind.
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Moved:
+		return "moved"
+	case Reordered:
+		return "reordered"
+	case Modified:
+		return "modified"
+	default:
+		return fmt.Sprintf("ChangeKind(%d)", int(k))
+	}
+}
+
+// Change is a difference of one node, identified by ID, between two trees.
+type Change[T any] struct {
+	Kind ChangeKind
+	ID   int
+	// OldParentID is the parent in the old tree when HasOldParent.
+	OldParentID  int
+	HasOldParent bool
+	// NewParentID is the parent in the new tree when HasNewParent.
+	NewParentID  int
+	HasNewParent bool
+	// Index is the position among the nexts of the new parent for Added, Moved and Reordered.
+	Index   int
+	OldData T
+	NewData T
+}
+
+// ChangeSet is the list of changes that turns a tree into another, in the order Patch applies them.
+type ChangeSet[T any] []Change[T]
+
+// String renders one change per line, in a review friendly form.
+func (c ChangeSet[T]) String() string {
+	var sb strings.Builder
+
+	for _, change := range c {
+		switch change.Kind {
+		case Added:
+			sb.WriteString(fmt.Sprintf("+ (%d) added under %s at %d: %v\n",
+				change.ID, parentLabel(change.NewParentID, change.HasNewParent), change.Index, change.NewData))
+		case Removed:
+			sb.WriteString(fmt.Sprintf("- (%d) removed: %v\n", change.ID, change.OldData))
+		case Moved:
+			sb.WriteString(fmt.Sprintf("> (%d) moved from %s to %s at %d\n", change.ID,
+				parentLabel(change.OldParentID, change.HasOldParent), parentLabel(change.NewParentID, change.HasNewParent), change.Index))
+		case Reordered:
+			sb.WriteString(fmt.Sprintf("^ (%d) reordered under %s to %d\n",
+				change.ID, parentLabel(change.NewParentID, change.HasNewParent), change.Index))
+		case Modified:
+			sb.WriteString(fmt.Sprintf("~ (%d) modified: %v -> %v\n", change.ID, change.OldData, change.NewData))
+		}
+	}
+
+	return sb.String()
+}
+
+func parentLabel(id int, hasParent bool) string {
+	if !hasParent {
+		return "(NULL)"
+	}
+
+	return fmt.Sprintf("(%d)", id)
+}
+
+type placement[T any] struct {
+	node      *node.Node[T]
+	parentID  int
+	hasParent bool
+	index     int
+}
+
+func placements[T any](t *Tree[T]) (map[int]placement[T], []int) {
+	placed := make(map[int]placement[T])
+	indexes := make(map[*node.Node[T]]int)
+	var order []int
+
+	for n := range t.PreOrder() {
+		for i, next := range n.GetNexts() {
+			indexes[next] = i
+		}
+
+		if _, exists := placed[n.GetID()]; exists {
+			continue
+		}
+
+		p := placement[T]{node: n}
+		if previous := n.GetPrevious(); previous != nil && n != t.root {
+			p.parentID = previous.GetID()
+			p.hasParent = true
+			p.index = indexes[n]
+		}
+
+		placed[n.GetID()] = p
+		order = append(order, n.GetID())
+	}
+
+	return placed, order
+}
+
+// Diff retrieves the changes, keyed by node ID, that turn oldTree into newTree, eq compares node data.
+func Diff[T any](oldTree *Tree[T], newTree *Tree[T], eq func(a, b T) bool) ChangeSet[T] {
+	oldPlaced, oldOrder := placements(oldTree)
+	newPlaced, newOrder := placements(newTree)
+
+	var changes ChangeSet[T]
+
+	for _, id := range oldOrder {
+		if _, exists := newPlaced[id]; !exists {
+			old := oldPlaced[id]
+			changes = append(changes, Change[T]{
+				Kind: Removed, ID: id, OldParentID: old.parentID, HasOldParent: old.hasParent, OldData: old.node.GetData(),
+			})
+		}
+	}
+
+	reordered := reorderedIDs(oldPlaced, newPlaced, newOrder)
+
+	var modified ChangeSet[T]
+	for _, id := range newOrder {
+		current := newPlaced[id]
+		change := Change[T]{
+			ID: id, NewParentID: current.parentID, HasNewParent: current.hasParent, Index: current.index, NewData: current.node.GetData(),
+		}
+
+		old, exists := oldPlaced[id]
+		if !exists {
+			change.Kind = Added
+			changes = append(changes, change)
+			continue
+		}
+
+		change.OldParentID, change.HasOldParent, change.OldData = old.parentID, old.hasParent, old.node.GetData()
+
+		switch {
+		case old.hasParent != current.hasParent || old.parentID != current.parentID:
+			change.Kind = Moved
+			changes = append(changes, change)
+		case reordered[id]:
+			change.Kind = Reordered
+			changes = append(changes, change)
+		}
+
+		if !eq(change.OldData, change.NewData) {
+			change.Kind = Modified
+			modified = append(modified, change)
+		}
+	}
+
+	return append(changes, modified...)
+}
+
+// reorderedIDs retrieves the nodes that kept their parent but are out of the longest
+// sequence of siblings that kept their relative order.
+func reorderedIDs[T any](oldPlaced map[int]placement[T], newPlaced map[int]placement[T], newOrder []int) map[int]bool {
+	reordered := make(map[int]bool)
+
+	for _, parentID := range newOrder {
+		var kept []int
+		for _, next := range newPlaced[parentID].node.GetNexts() {
+			old, exists := oldPlaced[next.GetID()]
+			if exists && old.hasParent && old.parentID == parentID && newPlaced[next.GetID()].node == next {
+				kept = append(kept, next.GetID())
+			}
+		}
+
+		inOrder := longestIncreasing(kept, func(id int) int {
+			return oldPlaced[id].index
+		})
+
+		for _, id := range kept {
+			if !inOrder[id] {
+				reordered[id] = true
+			}
+		}
+	}
+
+	return reordered
+}
+
+// longestIncreasing retrieves the IDs in the longest subsequence with increasing key.
+func longestIncreasing(ids []int, key func(id int) int) map[int]bool {
+	var tails []int
+	previous := make([]int, len(ids))
+
+	for i, id := range ids {
+		position := sort.Search(len(tails), func(j int) bool {
+			return key(ids[tails[j]]) >= key(id)
+		})
+
+		previous[i] = -1
+		if position > 0 {
+			previous[i] = tails[position-1]
+		}
+
+		if position == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[position] = i
+		}
+	}
+
+	kept := make(map[int]bool)
+	if len(tails) == 0 {
+		return kept
+	}
+
+	for i := tails[len(tails)-1]; i >= 0; i = previous[i] {
+		kept[ids[i]] = true
+	}
+
+	return kept
+}
+
+// Patch applies changes to Tree, when a change can´t be applied Tree is left unchanged.
+func Patch[T any](t *Tree[T], changes ChangeSet[T]) error {
+	nodes, parents, err := t.planPatch(changes)
+	if err != nil {
+		return err
+	}
+
+	removed := make(map[*node.Node[T]]bool)
+	for _, change := range changes {
+		if change.Kind == Removed {
+			removed[nodes[change.ID]] = true
+		}
+	}
+
+	for _, change := range changes {
+		if change.Kind != Moved && change.Kind != Reordered {
+			continue
+		}
+
+		n := nodes[change.ID]
+		if n == t.root {
+			t.root = nil
+		}
+		n.Detach()
+	}
+
+	for _, change := range changes {
+		if change.Kind != Removed {
+			continue
+		}
+
+		n := nodes[change.ID]
+		if n == t.root {
+			t.root = nil
+		}
+
+		// Descendants go away with the top-most removed node.
+		if !removed[n.GetPrevious()] {
+			n.Detach()
+		}
+	}
+
+	for _, change := range changes {
+		if change.Kind != Added && change.Kind != Moved && change.Kind != Reordered {
+			continue
+		}
+
+		n := nodes[change.ID]
+		parent := parents[n]
+		if parent == nil {
+			t.root = n
+			continue
+		}
+
+		index := change.Index
+		if nexts := len(parent.GetNexts()); index > nexts {
+			index = nexts
+		}
+		if !n.SetParentAt(parent, index) {
+			// planPatch rules this out, keep the index coherent anyway.
+			t.Reindex()
+			return ErrCycle
+		}
+	}
+
+	for _, change := range changes {
+		if change.Kind == Modified {
+			nodes[change.ID].SetData(change.NewData)
+		}
+	}
+
+	t.Reindex()
+
+	return nil
+}
+
+// planPatch retrieves the nodes changes refer to and the new parent, nil for root, of the
+// placed ones, checking that applying changes leaves a valid Tree.
+func (t *Tree[T]) planPatch(changes ChangeSet[T]) (map[int]*node.Node[T], map[*node.Node[T]]*node.Node[T], error) {
+	// Changes name nodes by ID, so nodes attached or detached through node.Node must be seen.
+	t.Reindex()
+
+	nodes := make(map[int]*node.Node[T])
+	for _, change := range changes {
+		existing, exists := t.index[change.ID]
+		switch {
+		case change.Kind == Added && exists:
+			return nil, nil, &DuplicateIDError{ID: change.ID}
+		case change.Kind == Added:
+			if _, added := nodes[change.ID]; !added {
+				nodes[change.ID] = node.New(change.NewData).WithID(change.ID)
+			}
+		case !exists:
+			return nil, nil, ErrNodeNotFound
+		default:
+			nodes[change.ID] = existing
+		}
+	}
+
+	placed := make(map[*node.Node[T]]*node.Node[T])
+	removed := make(map[*node.Node[T]]bool)
+	var order []*node.Node[T]
+	for _, change := range changes {
+		n := nodes[change.ID]
+		switch change.Kind {
+		case Removed:
+			removed[n] = true
+			continue
+		case Modified:
+			continue
+		}
+
+		if change.Index < 0 {
+			return nil, nil, ErrIndexOutOfRange
+		}
+
+		var parent *node.Node[T]
+		if change.HasNewParent {
+			found := false
+			if parent, found = nodes[change.NewParentID]; !found {
+				parent, found = t.index[change.NewParentID]
+			}
+			if !found {
+				return nil, nil, ErrParentNotFound
+			}
+		}
+		placed[n] = parent
+		order = append(order, n)
+	}
+
+	// Nodes kept after patching, with their parents.
+	parents := make(map[*node.Node[T]]*node.Node[T])
+	for n := range t.PreOrder() {
+		if removed[n] {
+			continue
+		}
+
+		parent, found := placed[n]
+		_, kept := parents[n.GetPrevious()]
+		switch {
+		case found:
+			parents[n] = parent
+		case n == t.root:
+			parents[n] = nil
+		case kept:
+			parents[n] = n.GetPrevious()
+		}
+	}
+
+	for _, n := range order {
+		parents[n] = placed[n]
+	}
+
+	for _, n := range order {
+		if parent := placed[n]; parent != nil {
+			if _, kept := parents[parent]; !kept {
+				return nil, nil, ErrParentNotFound
+			}
+		}
+	}
+
+	roots := 0
+	for _, parent := range parents {
+		if parent == nil {
+			roots++
+		}
+	}
+	if roots > 1 {
+		return nil, nil, ErrMultipleRoots
+	}
+
+	if hasCycle(order, parents) {
+		return nil, nil, ErrCycle
+	}
+
+	return nodes, placed, nil
+}
+
+// hasCycle retrieves if following parents from the placed nodes returns to a node already in the path.
+func hasCycle[T any](placed []*node.Node[T], parents map[*node.Node[T]]*node.Node[T]) bool {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	state := make(map[*node.Node[T]]int)
+	for _, n := range placed {
+		var path []*node.Node[T]
+		for current := n; current != nil && state[current] != visited; current = parents[current] {
+			if state[current] == visiting {
+				return true
+			}
+			state[current] = visiting
+			path = append(path, current)
+		}
+
+		for _, done := range path {
+			state[done] = visited
+		}
+	}
+
+	return false
+}
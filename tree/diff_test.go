@@ -0,0 +1,200 @@
+package tree_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildChangedTree() *tree.Tree[int] {
+	tr := buildMoveTree()
+	tr.Remove(6)
+	tr.Add(1, node.New(7).WithID(7))
+	tr.Move(4, 1, tree.AtIndex(0))
+	tr.Move(5, 2, tree.Before(3))
+	n, _ := tr.Get(3)
+	n.SetData(30)
+
+	return tr
+}
+
+func TestDiff_WhenTreesAreEqual_ShouldBeEmpty(t *testing.T) {
+	// Act
+	changes := tree.Diff(buildMoveTree(), buildMoveTree(), intEq)
+
+	// Assert
+	assert.Empty(t, changes)
+	assert.Equal(t, "", changes.String())
+}
+
+func TestDiff_WhenTreesChanged_ShouldReportEveryKind(t *testing.T) {
+	// Act
+	changes := tree.Diff(buildMoveTree(), buildChangedTree(), intEq)
+
+	// Assert
+	assert.Equal(t, tree.ChangeSet[int]{
+		{Kind: tree.Removed, ID: 6, OldParentID: 1, HasOldParent: true, OldData: 6},
+		{
+			Kind: tree.Moved, ID: 4, OldParentID: 2, HasOldParent: true, NewParentID: 1, HasNewParent: true,
+			Index: 0, OldData: 4, NewData: 4,
+		},
+		{Kind: tree.Added, ID: 7, NewParentID: 1, HasNewParent: true, Index: 1, NewData: 7},
+		{
+			Kind: tree.Reordered, ID: 5, OldParentID: 2, HasOldParent: true, NewParentID: 2, HasNewParent: true,
+			Index: 0, OldData: 5, NewData: 5,
+		},
+		{
+			Kind: tree.Modified, ID: 3, OldParentID: 2, HasOldParent: true, NewParentID: 2, HasNewParent: true,
+			Index: 1, OldData: 3, NewData: 30,
+		},
+	}, changes)
+}
+
+func TestChangeSet_String_ShouldRenderOneLinePerChange(t *testing.T) {
+	// Arrange
+	changes := tree.Diff(buildMoveTree(), buildChangedTree(), intEq)
+
+	// Act
+	text := changes.String()
+
+	// Assert
+	assert.Equal(t, "- (6) removed: 6\n"+
+		"> (4) moved from (2) to (1) at 0\n"+
+		"+ (7) added under (1) at 1: 7\n"+
+		"^ (5) reordered under (2) to 0\n"+
+		"~ (3) modified: 3 -> 30\n", text)
+}
+
+func TestChangeKind_String_WhenUnknown_ShouldShowValue(t *testing.T) {
+	// Assert
+	assert.Equal(t, "moved", tree.Moved.String())
+	assert.Equal(t, "ChangeKind(42)", tree.ChangeKind(42).String())
+}
+
+func TestPatch_ShouldTurnOldTreeIntoNewTree(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	changed := buildChangedTree()
+	changes := tree.Diff(tr, changed, intEq)
+
+	// Act
+	err := tree.Patch(tr, changes)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, tree.Equal(tr, changed, intEq))
+	_, found := tr.Get(6)
+	assert.False(t, found)
+	added, _ := tr.Get(7)
+	assert.Equal(t, 1, added.GetPrevious().GetID())
+}
+
+func TestPatch_WhenRootChanges_ShouldReplaceRoot(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	changed := tree.New[int]()
+	changed.AddRoot(node.New(2).WithID(2))
+	changed.Add(2, node.New(0).WithID(0))
+	changed.Add(0, node.New(1).WithID(1))
+	changed.Add(2, node.New(8).WithID(8))
+	changes := tree.Diff(tr, changed, intEq)
+
+	// Act
+	err := tree.Patch(tr, changes)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, tree.Equal(tr, changed, intEq))
+	root, _ := tr.GetRoot()
+	assert.Equal(t, 2, root.GetID())
+}
+
+func TestPatch_WhenNonLeafIsRemoved_ShouldRemoveSubtree(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(1, node.New(2).WithID(2))
+	changed := tree.New[int]()
+	changed.AddRoot(node.New(0).WithID(0))
+
+	// Act
+	err := tree.Patch(tr, tree.Diff(tr, changed, intEq))
+
+	// Assert
+	assert.Nil(t, err)
+	assert.True(t, tree.Equal(tr, changed, intEq))
+	_, found := tr.Get(2)
+	assert.False(t, found)
+}
+
+func TestPatch_WhenTreesAreRandom_ShouldRoundTrip(t *testing.T) {
+	// Arrange
+	r := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 500; i++ {
+		tr := buildRandomTree(r)
+		changed := buildRandomTree(r)
+
+		// Act
+		err := tree.Patch(tr, tree.Diff(tr, changed, intEq))
+
+		// Assert
+		assert.Nil(t, err)
+		assert.True(t, tree.Equal(tr, changed, intEq))
+	}
+}
+
+func TestPatch_WhenChangeDoesNotMatchTree_ShouldReturnErrorAndKeepTree(t *testing.T) {
+	// Arrange
+	cases := []struct {
+		changes tree.ChangeSet[int]
+		err     error
+	}{
+		{tree.ChangeSet[int]{{Kind: tree.Added, ID: 1, NewParentID: 0, HasNewParent: true}}, tree.ErrDuplicateID},
+		{tree.ChangeSet[int]{{Kind: tree.Removed, ID: 42}}, tree.ErrNodeNotFound},
+		{tree.ChangeSet[int]{
+			{Kind: tree.Moved, ID: 5, NewParentID: 1, HasNewParent: true},
+			{Kind: tree.Added, ID: 42, NewParentID: 43, HasNewParent: true},
+		}, tree.ErrParentNotFound},
+		{tree.ChangeSet[int]{
+			{Kind: tree.Removed, ID: 2},
+			{Kind: tree.Added, ID: 42, NewParentID: 3, HasNewParent: true},
+		}, tree.ErrParentNotFound},
+		{tree.ChangeSet[int]{{Kind: tree.Added, ID: 42}}, tree.ErrMultipleRoots},
+		{tree.ChangeSet[int]{{Kind: tree.Moved, ID: 6, NewParentID: 2, HasNewParent: true, Index: -1}}, tree.ErrIndexOutOfRange},
+		{tree.ChangeSet[int]{
+			{Kind: tree.Added, ID: 42, NewParentID: 1, HasNewParent: true},
+			{Kind: tree.Moved, ID: 2, NewParentID: 3, HasNewParent: true},
+		}, tree.ErrCycle},
+	}
+
+	for _, c := range cases {
+		tr := buildMoveTree()
+
+		// Act
+		err := tree.Patch(tr, c.changes)
+
+		// Assert
+		assert.ErrorIs(t, err, c.err)
+		assert.True(t, tree.Equal(tr, buildMoveTree(), intEq))
+		_, found := tr.Get(42)
+		assert.False(t, found)
+	}
+}
+
+func buildRandomTree(r *rand.Rand) *tree.Tree[int] {
+	const ids = 12
+
+	order := r.Perm(ids)[:1+r.Intn(ids)]
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(r.Intn(3)).WithID(order[0]))
+	for i, id := range order[1:] {
+		tr.Add(order[r.Intn(i+1)], node.New(r.Intn(3)).WithID(id))
+	}
+
+	return tr
+}
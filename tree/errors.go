@@ -0,0 +1,46 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNoRoot is returned when an operation needs a root and Tree is empty.
+	ErrNoRoot = errors.New("tree has no root")
+	// ErrRootAlreadySet is returned when adding a root to a Tree that already has one.
+	ErrRootAlreadySet = errors.New("tree already has a root")
+	// ErrParentNotFound is returned when the parent ID is not in Tree.
+	ErrParentNotFound = errors.New("parent not found")
+	// ErrNodeNotFound is returned when the node ID is not in Tree.
+	ErrNodeNotFound = errors.New("node not found")
+	// ErrDuplicateID is matched by every DuplicateIDError.
+	ErrDuplicateID = errors.New("duplicate node id")
+	// ErrCycle is returned when an operation would make a node its own ancestor.
+	ErrCycle = errors.New("operation would create a cycle")
+	// ErrNodeAttached is returned when adding a node that already has a parent, use Move instead.
+	ErrNodeAttached = errors.New("node already attached")
+	// ErrMultipleRoots is returned when an operation would leave Tree with more than one root.
+	ErrMultipleRoots = errors.New("operation would leave multiple roots")
+	// ErrSiblingNotFound is returned when a position references a sibling that is not in the parent.
+	ErrSiblingNotFound = errors.New("sibling not found")
+	// ErrIndexOutOfRange is returned when a position index is outside the parent nexts.
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrRootFilteredOut is returned when the root doesn´t respect the filter rule.
+	ErrRootFilteredOut = errors.New("root filtered out")
+)
+
+// DuplicateIDError is returned when a node ID already exists in Tree.
+type DuplicateIDError struct {
+	ID int
+}
+
+// Error retrieves the error message.
+func (e *DuplicateIDError) Error() string {
+	return fmt.Sprintf("%s %d", ErrDuplicateID, e.ID)
+}
+
+// Is allows errors.Is(err, ErrDuplicateID).
+func (e *DuplicateIDError) Is(target error) bool {
+	return target == ErrDuplicateID
+}
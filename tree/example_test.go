@@ -46,11 +46,22 @@ func ExampleTree_Add() {
 	tr := tree.New[bool]()
 	tr.AddRoot(node.New(true))
 
-	tr.Add(0, node.New(false))
+	tr.Add(0, node.New(false).WithID(1))
 
 	// Do more things
 }
 
+// ExampleTree_TryAdd demonstrates how to add node to tree checking why it failed.
+func ExampleTree_TryAdd() {
+	tr := tree.New[string]()
+	tr.AddRoot(node.New("root").WithID(0))
+
+	err := tr.TryAdd(0, node.New("duplicated").WithID(0))
+	fmt.Println(err)
+
+	// Output: duplicate node id 0
+}
+
 // ExampleTree_Get demonstrates how to retrieve node from tree.
 func ExampleTree_Get() {
 	tr := tree.New[uint]()
@@ -68,10 +79,10 @@ func ExampleTree_Get() {
 // ExampleTree_Backtrack demonstrates how to retrieve path of nodes from node to root.
 func ExampleTree_Backtrack() {
 	tr := tree.New[string]()
-	tr.AddRoot(node.New("root"))
-	tr.Add(0, node.New("level1"))
-	tr.Add(1, node.New("level2"))
-	tr.Add(2, node.New("leaf"))
+	tr.AddRoot(node.New("root").WithID(0))
+	tr.Add(0, node.New("level1").WithID(1))
+	tr.Add(1, node.New("level2").WithID(2))
+	tr.Add(2, node.New("leaf").WithID(3))
 
 	nodes, ok := tr.Backtrack(3)
 	if !ok {
@@ -87,10 +98,10 @@ func ExampleTree_Backtrack() {
 // ExampleTree_GetStructure demonstrates how to retrieve tree structure.
 func ExampleTree_GetStructure() {
 	tr := tree.New[string]()
-	tr.AddRoot(node.New("root"))
-	tr.Add(0, node.New("level1"))
-	tr.Add(1, node.New("level2"))
-	tr.Add(2, node.New("leaf"))
+	tr.AddRoot(node.New("root").WithID(0))
+	tr.Add(0, node.New("level1").WithID(1))
+	tr.Add(1, node.New("level2").WithID(2))
+	tr.Add(2, node.New("leaf").WithID(3))
 
 	structure, ok := tr.GetStructure()
 	if !ok {
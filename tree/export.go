@@ -0,0 +1,221 @@
+package tree
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+)
+
+// ExportOptions customizes ToDOT, ToMermaid and ToPlantUML.
+type ExportOptions[T any] struct {
+	// Label describes node data, it defaults to fmt.Sprint.
+	Label func(obj T) string
+	// Attributes retrieves extra attributes of a node: DOT attributes, Mermaid style
+	// properties and, for PlantUML, only "color".
+	Attributes func(obj T) map[string]string
+	// Highlight marks nodes, and edges between them, usually a path from Backtrack.
+	Highlight []*node.Node[T]
+}
+
+const highlightColor = "red"
+
+var (
+	dotEscaper      = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\r", "", "\n", `\n`)
+	mermaidEscaper  = strings.NewReplacer(`#`, `#35;`, `"`, `#quot;`, `<`, `#lt;`, `>`, `#gt;`, "\r", "", "\n", `<br/>`)
+	plantUMLEscaper = strings.NewReplacer(`~`, `~~`, `<`, `~<`, `>`, `~>`, `[`, `~[`, "\r", "", "\n", `\n`)
+)
+
+type exportedNode[T any] struct {
+	node        *node.Node[T]
+	seq         int
+	parentSeq   int
+	depth       int
+	highlighted bool
+	label       string
+	attributes  map[string]string
+}
+
+// exportNodes retrieves Tree nodes in pre-order numbered by position, so duplicated IDs stay apart.
+func (t *Tree[T]) exportNodes(opts ExportOptions[T]) []exportedNode[T] {
+	label := opts.Label
+	if label == nil {
+		label = func(obj T) string {
+			return fmt.Sprint(obj)
+		}
+	}
+
+	highlighted := make(map[*node.Node[T]]bool)
+	for _, n := range opts.Highlight {
+		highlighted[n] = true
+	}
+
+	seqs := make(map[*node.Node[T]]int)
+	var nodes []exportedNode[T]
+	for n, depth := range t.PreOrderWithDepth() {
+		exported := exportedNode[T]{
+			node:        n,
+			seq:         len(nodes),
+			parentSeq:   -1,
+			depth:       depth,
+			highlighted: highlighted[n],
+			label:       label(n.GetData()),
+			attributes:  make(map[string]string),
+		}
+
+		if n != t.root {
+			exported.parentSeq = seqs[n.GetPrevious()]
+		}
+		if opts.Attributes != nil {
+			for key, value := range opts.Attributes(n.GetData()) {
+				exported.attributes[key] = value
+			}
+		}
+
+		seqs[n] = exported.seq
+		nodes = append(nodes, exported)
+	}
+
+	return nodes
+}
+
+// ToDOT retrieves Tree as a Graphviz digraph.
+func (t *Tree[T]) ToDOT(opts ExportOptions[T]) (string, bool) {
+	if t.root == nil {
+		return "", false
+	}
+
+	nodes := t.exportNodes(opts)
+
+	var sb strings.Builder
+	sb.WriteString("digraph {\n")
+
+	for _, n := range nodes {
+		n.attributes["label"] = n.label
+		if n.highlighted {
+			n.attributes["color"] = highlightColor
+		}
+		sb.WriteString(fmt.Sprintf("\tn%d [%s];\n", n.seq, dotAttributes(n.attributes)))
+	}
+
+	for _, n := range nodes {
+		if n.parentSeq < 0 {
+			continue
+		}
+
+		edge := ""
+		if n.highlighted && nodes[n.parentSeq].highlighted {
+			edge = fmt.Sprintf(" [color=\"%s\"]", highlightColor)
+		}
+		sb.WriteString(fmt.Sprintf("\tn%d -> n%d%s;\n", n.parentSeq, n.seq, edge))
+	}
+
+	sb.WriteString("}\n")
+
+	return sb.String(), true
+}
+
+func dotAttributes(attributes map[string]string) string {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", key, dotEscaper.Replace(attributes[key])))
+	}
+
+	return strings.Join(pairs, ", ")
+}
+
+// ToMermaid retrieves Tree as a Mermaid top-down graph.
+func (t *Tree[T]) ToMermaid(opts ExportOptions[T]) (string, bool) {
+	if t.root == nil {
+		return "", false
+	}
+
+	nodes := t.exportNodes(opts)
+
+	var sb strings.Builder
+	sb.WriteString("graph TD\n")
+
+	for _, n := range nodes {
+		sb.WriteString(fmt.Sprintf("    n%d[\"%s\"]\n", n.seq, mermaidEscaper.Replace(n.label)))
+	}
+
+	var highlightedLinks []string
+	for _, n := range nodes {
+		if n.parentSeq < 0 {
+			continue
+		}
+
+		if n.highlighted && nodes[n.parentSeq].highlighted {
+			// Links are numbered by declaration, the root has no link.
+			highlightedLinks = append(highlightedLinks, fmt.Sprint(n.seq-1))
+		}
+		sb.WriteString(fmt.Sprintf("    n%d --> n%d\n", n.parentSeq, n.seq))
+	}
+
+	var highlighted []string
+	for _, n := range nodes {
+		if n.highlighted {
+			highlighted = append(highlighted, fmt.Sprintf("n%d", n.seq))
+		}
+
+		if len(n.attributes) == 0 {
+			continue
+		}
+
+		keys := make([]string, 0, len(n.attributes))
+		for key := range n.attributes {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		properties := make([]string, 0, len(keys))
+		for _, key := range keys {
+			properties = append(properties, key+":"+n.attributes[key])
+		}
+		sb.WriteString(fmt.Sprintf("    style n%d %s\n", n.seq, strings.Join(properties, ",")))
+	}
+
+	if len(highlighted) > 0 {
+		sb.WriteString(fmt.Sprintf("    classDef highlight stroke:%s,stroke-width:2px\n", highlightColor))
+		sb.WriteString(fmt.Sprintf("    class %s highlight\n", strings.Join(highlighted, ",")))
+	}
+	if len(highlightedLinks) > 0 {
+		sb.WriteString(fmt.Sprintf("    linkStyle %s stroke:%s\n", strings.Join(highlightedLinks, ","), highlightColor))
+	}
+
+	return sb.String(), true
+}
+
+// ToPlantUML retrieves Tree as a PlantUML work breakdown structure.
+func (t *Tree[T]) ToPlantUML(opts ExportOptions[T]) (string, bool) {
+	if t.root == nil {
+		return "", false
+	}
+
+	var sb strings.Builder
+	sb.WriteString("@startwbs\n")
+
+	for _, n := range t.exportNodes(opts) {
+		color := n.attributes["color"]
+		if n.highlighted {
+			color = highlightColor
+		}
+
+		sb.WriteString(strings.Repeat("*", n.depth+1))
+		if color != "" {
+			sb.WriteString(fmt.Sprintf("[#%s]", strings.TrimPrefix(color, "#")))
+		}
+		sb.WriteString(" " + plantUMLEscaper.Replace(n.label) + "\n")
+	}
+
+	sb.WriteString("@endwbs\n")
+
+	return sb.String(), true
+}
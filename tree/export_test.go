@@ -0,0 +1,121 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildExportTree() *tree.Tree[string] {
+	tr := tree.New[string]()
+	tr.AddRoot(node.New("root").WithID(0))
+	tr.Add(0, node.New(`say "hi"`).WithID(1))
+	tr.Add(0, node.New("a<b>\nc").WithID(2))
+	tr.Add(2, node.New("leaf").WithID(3))
+
+	return tr
+}
+
+func TestTree_Exporters_WhenThereIsNoRoot_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	tr := tree.New[string]()
+
+	// Act
+	_, dotOK := tr.ToDOT(tree.ExportOptions[string]{})
+	_, mermaidOK := tr.ToMermaid(tree.ExportOptions[string]{})
+	_, plantUMLOK := tr.ToPlantUML(tree.ExportOptions[string]{})
+
+	// Assert
+	assert.False(t, dotOK)
+	assert.False(t, mermaidOK)
+	assert.False(t, plantUMLOK)
+}
+
+func TestTree_ToDOT_ShouldEscapeLabelsAndHighlightPath(t *testing.T) {
+	// Arrange
+	tr := buildExportTree()
+	path, _ := tr.Backtrack(3)
+
+	// Act
+	text, ok := tr.ToDOT(tree.ExportOptions[string]{
+		Highlight: path,
+		Attributes: func(obj string) map[string]string {
+			if obj == "leaf" {
+				return map[string]string{"shape": "box"}
+			}
+			return nil
+		},
+	})
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, "digraph {\n"+
+		"\tn0 [color=\"red\", label=\"root\"];\n"+
+		"\tn1 [label=\"say \\\"hi\\\"\"];\n"+
+		"\tn2 [color=\"red\", label=\"a<b>\\nc\"];\n"+
+		"\tn3 [color=\"red\", label=\"leaf\", shape=\"box\"];\n"+
+		"\tn0 -> n1;\n"+
+		"\tn0 -> n2 [color=\"red\"];\n"+
+		"\tn2 -> n3 [color=\"red\"];\n"+
+		"}\n", text)
+}
+
+func TestTree_ToMermaid_ShouldEscapeLabelsAndHighlightPath(t *testing.T) {
+	// Arrange
+	tr := buildExportTree()
+	path, _ := tr.Backtrack(3)
+
+	// Act
+	text, ok := tr.ToMermaid(tree.ExportOptions[string]{
+		Label: func(obj string) string {
+			return "#" + obj
+		},
+		Highlight: path,
+		Attributes: func(obj string) map[string]string {
+			if obj == "leaf" {
+				return map[string]string{"fill": "#eee"}
+			}
+			return nil
+		},
+	})
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, "graph TD\n"+
+		"    n0[\"#35;root\"]\n"+
+		"    n1[\"#35;say #quot;hi#quot;\"]\n"+
+		"    n2[\"#35;a#lt;b#gt;<br/>c\"]\n"+
+		"    n3[\"#35;leaf\"]\n"+
+		"    n0 --> n1\n"+
+		"    n0 --> n2\n"+
+		"    n2 --> n3\n"+
+		"    style n3 fill:#eee\n"+
+		"    classDef highlight stroke:red,stroke-width:2px\n"+
+		"    class n0,n2,n3 highlight\n"+
+		"    linkStyle 1,2 stroke:red\n", text)
+}
+
+func TestTree_ToPlantUML_ShouldWriteWBS(t *testing.T) {
+	// Arrange
+	tr := buildExportTree()
+	highlight, _ := tr.Get(1)
+
+	// Act
+	text, ok := tr.ToPlantUML(tree.ExportOptions[string]{
+		Highlight: []*node.Node[string]{highlight},
+		Attributes: func(obj string) map[string]string {
+			return map[string]string{"color": "#Orange"}
+		},
+	})
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, "@startwbs\n"+
+		"*[#Orange] root\n"+
+		"**[#red] say \"hi\"\n"+
+		"**[#Orange] a~<b~>\\nc\n"+
+		"***[#Orange] leaf\n"+
+		"@endwbs\n", text)
+}
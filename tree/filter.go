@@ -0,0 +1,28 @@
+package tree
+
+import "github.com/johnfercher/go-tree/node"
+
+// FilterWithMode creates a copy of Tree keeping the nodes defined by mode.
+func (t *Tree[T]) FilterWithMode(filterFunc func(obj T) bool, mode node.FilterMode) (*Tree[T], bool) {
+	filtered, _, ok := t.FilterWithReasons(filterFunc, mode)
+	return filtered, ok
+}
+
+// FilterWithReasons creates a copy of Tree keeping the nodes defined by mode,
+// retrieving why each copied node was kept.
+func (t *Tree[T]) FilterWithReasons(filterFunc func(obj T) bool, mode node.FilterMode) (*Tree[T], map[*node.Node[T]]node.KeepReason, bool) {
+	if t.root == nil {
+		return nil, nil, false
+	}
+
+	newRoot, reasons, ok := t.root.FilterWithReasons(filterFunc, mode)
+	if !ok {
+		return nil, nil, false
+	}
+
+	newTree := t.empty()
+	newTree.root = newRoot
+	newTree.indexSubtree(newRoot)
+
+	return newTree, reasons, true
+}
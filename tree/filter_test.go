@@ -0,0 +1,74 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_FilterWithMode_WhenThereIsNoRoot_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+
+	// Act
+	filtered, ok := tr.FilterWithMode(func(obj int) bool {
+		return true
+	}, node.KeepAncestors)
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, filtered)
+}
+
+func TestTree_FilterWithMode_WhenKeepAncestors_ShouldKeepPathToMatches(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	filtered, ok := tr.FilterWithMode(func(obj int) bool {
+		return obj == 6
+	}, node.KeepAncestors)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []string{"0", "0/1", "0/1/6"}, filtered.ToMaterializedPaths("/"))
+	_, found := filtered.Get(6)
+	assert.True(t, found)
+}
+
+func TestTree_FilterWithReasons_WhenNothingMatches_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	filtered, reasons, ok := tr.FilterWithReasons(func(obj int) bool {
+		return obj > 42
+	}, node.KeepSubtrees)
+
+	// Assert
+	assert.False(t, ok)
+	assert.Nil(t, filtered)
+	assert.Nil(t, reasons)
+}
+
+func TestTree_FilterWithReasons_WhenKeepSubtrees_ShouldReportReasons(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	filtered, reasons, ok := tr.FilterWithReasons(func(obj int) bool {
+		return obj == 2
+	}, node.KeepSubtrees)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []string{"0", "0/2", "0/2/3", "0/2/4", "0/2/5"}, filtered.ToMaterializedPaths("/"))
+	n3, _ := filtered.Get(3)
+	n2, _ := filtered.Get(2)
+	root, _ := filtered.GetRoot()
+	assert.Equal(t, node.DescendantOfMatch, reasons[n3])
+	assert.Equal(t, node.Matched, reasons[n2])
+	assert.Equal(t, node.AncestorOfMatch, reasons[root])
+}
@@ -0,0 +1,89 @@
+package tree
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/johnfercher/go-tree/node"
+)
+
+type flatNode[T any] struct {
+	ID       int  `json:"id"`
+	ParentID *int `json:"parent_id"`
+	Data     T    `json:"data"`
+}
+
+// MarshalJSON encodes Tree in the format defined by WithJSONFormat, nested by default.
+func (t *Tree[T]) MarshalJSON() ([]byte, error) {
+	if t.root == nil {
+		return []byte("null"), nil
+	}
+
+	if t.options.jsonFormat != FlatJSON {
+		return t.root.MarshalJSON()
+	}
+
+	var nodes []flatNode[T]
+	for n := range t.root.PreOrder() {
+		flat := flatNode[T]{ID: n.GetID(), Data: n.GetData()}
+		if previous := n.GetPrevious(); previous != nil {
+			parentID := previous.GetID()
+			flat.ParentID = &parentID
+		}
+		nodes = append(nodes, flat)
+	}
+
+	return json.Marshal(nodes)
+}
+
+// UnmarshalJSON decodes Tree from the nested or the flat format, replacing its nodes only on success.
+func (t *Tree[T]) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+
+	var root *node.Node[T]
+	var err error
+
+	switch {
+	case bytes.Equal(trimmed, []byte("null")):
+	case len(trimmed) > 0 && trimmed[0] == '[':
+		root, err = t.unmarshalFlat(trimmed)
+	default:
+		root = &node.Node[T]{}
+		err = json.Unmarshal(trimmed, root)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	decoded := t.empty()
+	if root != nil {
+		if err := decoded.TryAddRoot(root); err != nil {
+			return err
+		}
+	}
+
+	t.root = decoded.root
+	t.index = decoded.index
+	t.invalidate()
+
+	return nil
+}
+
+func (t *Tree[T]) unmarshalFlat(data []byte) (*node.Node[T], error) {
+	var flats []flatNode[T]
+	if err := json.Unmarshal(data, &flats); err != nil {
+		return nil, err
+	}
+
+	return fromAdjacency(flats, func(flat flatNode[T]) int {
+		return flat.ID
+	}, func(flat flatNode[T]) (int, bool) {
+		if flat.ParentID == nil {
+			return 0, false
+		}
+		return *flat.ParentID, true
+	}, func(flat flatNode[T]) T {
+		return flat.Data
+	})
+}
@@ -0,0 +1,170 @@
+package tree_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildJSONTree(opts ...tree.Option) *tree.Tree[string] {
+	tr := tree.New[string](opts...)
+	tr.AddRoot(node.New("a").WithID(0))
+	tr.Add(0, node.New("b").WithID(1))
+	tr.Add(0, node.New("c").WithID(2))
+	tr.Add(1, node.New("d").WithID(3))
+
+	return tr
+}
+
+func TestTree_MarshalJSON_WhenThereIsNoRoot_ShouldEncodeNull(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+
+	// Act
+	bytes, err := json.Marshal(tr)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, "null", string(bytes))
+}
+
+func TestTree_MarshalJSON_WhenFormatIsNested_ShouldEncodeNested(t *testing.T) {
+	// Arrange
+	tr := buildJSONTree()
+
+	// Act
+	bytes, err := json.Marshal(tr)
+
+	// Assert
+	assert.Nil(t, err)
+	expected := `{"id":0,"data":"a","children":[{"id":1,"data":"b","children":[{"id":3,"data":"d"}]},{"id":2,"data":"c"}]}`
+	assert.Equal(t, expected, string(bytes))
+}
+
+func TestTree_MarshalJSON_WhenFormatIsFlat_ShouldEncodeFlat(t *testing.T) {
+	// Arrange
+	tr := buildJSONTree(tree.WithJSONFormat(tree.FlatJSON))
+
+	// Act
+	bytes, err := json.Marshal(tr)
+
+	// Assert
+	assert.Nil(t, err)
+	expected := `[{"id":0,"parent_id":null,"data":"a"},{"id":1,"parent_id":0,"data":"b"},` +
+		`{"id":3,"parent_id":1,"data":"d"},{"id":2,"parent_id":0,"data":"c"}]`
+	assert.Equal(t, expected, string(bytes))
+}
+
+func TestTree_UnmarshalJSON_ShouldRoundTripBothFormats(t *testing.T) {
+	for _, format := range []tree.JSONFormat{tree.NestedJSON, tree.FlatJSON} {
+		// Arrange
+		input, _ := json.Marshal(buildJSONTree(tree.WithJSONFormat(format)))
+		sut := tree.New[string](tree.WithJSONFormat(format))
+
+		// Act
+		err := json.Unmarshal(input, sut)
+		output, _ := json.Marshal(sut)
+
+		// Assert
+		assert.Nil(t, err)
+		assert.Equal(t, string(input), string(output))
+		n, found := sut.Get(3)
+		assert.True(t, found)
+		assert.Equal(t, 1, n.GetPrevious().GetID())
+	}
+}
+
+func TestTree_UnmarshalJSON_WhenFlatIsUnordered_ShouldKeepSiblingOrder(t *testing.T) {
+	// Arrange
+	input := `[{"id":2,"parent_id":0,"data":"c"},{"id":3,"parent_id":0,"data":"d"},{"id":0,"parent_id":null,"data":"a"}]`
+	var sut tree.Tree[string]
+
+	// Act
+	err := json.Unmarshal([]byte(input), &sut)
+
+	// Assert
+	assert.Nil(t, err)
+	root, _ := sut.GetRoot()
+	assert.Equal(t, 2, root.GetNexts()[0].GetID())
+	assert.Equal(t, 3, root.GetNexts()[1].GetID())
+}
+
+func TestTree_UnmarshalJSON_WhenNull_ShouldEmptyTree(t *testing.T) {
+	// Arrange
+	sut := buildJSONTree()
+
+	// Act
+	err := json.Unmarshal([]byte("null"), sut)
+
+	// Assert
+	assert.Nil(t, err)
+	_, hasRoot := sut.GetRoot()
+	assert.False(t, hasRoot)
+	_, found := sut.Get(0)
+	assert.False(t, found)
+}
+
+func TestTree_UnmarshalJSON_WhenInputIsInvalid_ShouldReturnErrorAndKeepTree(t *testing.T) {
+	// Arrange
+	sut := map[string]struct {
+		input    string
+		expected error
+	}{
+		"duplicate id":   {`[{"id":0,"parent_id":null,"data":1},{"id":0,"parent_id":0,"data":2}]`, tree.ErrDuplicateID},
+		"multiple roots": {`[{"id":0,"parent_id":null,"data":1},{"id":1,"parent_id":null,"data":2}]`, tree.ErrMultipleRoots},
+		"missing parent": {`[{"id":0,"parent_id":null,"data":1},{"id":1,"parent_id":5,"data":2}]`, tree.ErrParentNotFound},
+		"no root":        {`[{"id":0,"parent_id":1,"data":1},{"id":1,"parent_id":0,"data":2}]`, tree.ErrNoRoot},
+		"cycle": {
+			`[{"id":0,"parent_id":null,"data":1},{"id":1,"parent_id":2,"data":2},{"id":2,"parent_id":1,"data":3}]`,
+			tree.ErrCycle,
+		},
+		"nested duplicate id": {`{"id":0,"data":1,"children":[{"id":0,"data":2}]}`, tree.ErrDuplicateID},
+	}
+
+	for name, c := range sut {
+		tr := buildMoveTree()
+
+		// Act
+		err := json.Unmarshal([]byte(c.input), tr)
+
+		// Assert
+		assert.ErrorIs(t, err, c.expected, name)
+		assert.True(t, tree.Equal(tr, buildMoveTree(), intEq), name)
+		n, found := tr.Get(6)
+		assert.True(t, found, name)
+		assert.Equal(t, 6, n.GetData(), name)
+	}
+}
+
+func TestTree_MarshalJSON_WhenTreeIsDeeperThanNestedLimit_ShouldRequireFlat(t *testing.T) {
+	// Arrange
+	nested := buildDeepJSONTree()
+	flat := buildDeepJSONTree(tree.WithJSONFormat(tree.FlatJSON))
+
+	// Act
+	_, nestedErr := json.Marshal(nested)
+	bytes, flatErr := json.Marshal(flat)
+	decoded := tree.New[int]()
+	decodeErr := json.Unmarshal(bytes, decoded)
+
+	// Assert
+	assert.ErrorIs(t, nestedErr, node.ErrJSONTooDeep)
+	assert.Nil(t, flatErr)
+	assert.Nil(t, decodeErr)
+	assert.True(t, tree.Equal(flat, decoded, intEq))
+}
+
+func buildDeepJSONTree(opts ...tree.Option) *tree.Tree[int] {
+	const depth = 20_000
+
+	tr := tree.New[int](opts...)
+	tr.AddRoot(node.New(0).WithID(0))
+	for i := 1; i < depth; i++ {
+		tr.Add(i-1, node.New(i).WithID(i))
+	}
+
+	return tr
+}
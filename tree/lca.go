@@ -0,0 +1,184 @@
+package tree
+
+import (
+	"math/bits"
+
+	"github.com/johnfercher/go-tree/node"
+)
+
+// lifting keeps, for each node position, its 2^k ancestors for binary lifting.
+type lifting[T any] struct {
+	positions map[*node.Node[T]]int
+	nodes     []*node.Node[T]
+	depths    []int
+	ancestors [][]int
+}
+
+// PrepareLCA preprocesses Tree with binary lifting, so LCA, PathBetween and Distance
+// take logarithmic time until Tree changes.
+func (t *Tree[T]) PrepareLCA() {
+	l := &lifting[T]{positions: make(map[*node.Node[T]]int)}
+
+	var parents []int
+	maxDepth := 0
+	for n, depth := range t.PreOrderWithDepth() {
+		parent := len(l.nodes)
+		if depth > 0 {
+			parent = l.positions[n.GetPrevious()]
+		}
+
+		l.positions[n] = len(l.nodes)
+		l.nodes = append(l.nodes, n)
+		l.depths = append(l.depths, depth)
+		parents = append(parents, parent)
+
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+	}
+
+	l.ancestors = [][]int{parents}
+	for k := 1; k < bits.Len(uint(maxDepth)); k++ {
+		previous := l.ancestors[k-1]
+		current := make([]int, len(previous))
+		for i := range previous {
+			current[i] = previous[previous[i]]
+		}
+		l.ancestors = append(l.ancestors, current)
+	}
+
+	t.mu.Lock()
+	t.lifting = l
+	t.mu.Unlock()
+}
+
+// LCA retrieves the lowest common ancestor of two nodes, a node is an ancestor of itself.
+func (t *Tree[T]) LCA(firstID int, secondID int) (*node.Node[T], bool) {
+	first, found := t.Get(firstID)
+	if !found {
+		return nil, false
+	}
+
+	second, found := t.Get(secondID)
+	if !found {
+		return nil, false
+	}
+
+	return t.lca(first, second), true
+}
+
+// PathBetween retrieves the path from first node up to their lowest common ancestor and down to second node.
+func (t *Tree[T]) PathBetween(firstID int, secondID int) ([]*node.Node[T], bool) {
+	first, found := t.Get(firstID)
+	if !found {
+		return nil, false
+	}
+
+	second, found := t.Get(secondID)
+	if !found {
+		return nil, false
+	}
+
+	ancestor := t.lca(first, second)
+
+	var up []*node.Node[T]
+	for current := first; current != ancestor; current = current.GetPrevious() {
+		up = append(up, current)
+	}
+	up = append(up, ancestor)
+
+	var down []*node.Node[T]
+	for current := second; current != ancestor; current = current.GetPrevious() {
+		down = append(down, current)
+	}
+
+	for i := len(down) - 1; i >= 0; i-- {
+		up = append(up, down[i])
+	}
+
+	return up, true
+}
+
+// Distance retrieves the number of edges between two nodes.
+func (t *Tree[T]) Distance(firstID int, secondID int) (int, bool) {
+	first, found := t.Get(firstID)
+	if !found {
+		return 0, false
+	}
+
+	second, found := t.Get(secondID)
+	if !found {
+		return 0, false
+	}
+
+	ancestor := t.lca(first, second)
+
+	return t.depth(first) + t.depth(second) - 2*t.depth(ancestor), true
+}
+
+func (t *Tree[T]) prepared() *lifting[T] {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.lifting
+}
+
+func (t *Tree[T]) depth(n *node.Node[T]) int {
+	if l := t.prepared(); l != nil {
+		return l.depths[l.positions[n]]
+	}
+
+	depth := 0
+	for current := n.GetPrevious(); current != nil; current = current.GetPrevious() {
+		depth++
+	}
+
+	return depth
+}
+
+func (t *Tree[T]) lca(first *node.Node[T], second *node.Node[T]) *node.Node[T] {
+	if l := t.prepared(); l != nil {
+		return l.lca(first, second)
+	}
+
+	firstDepth, secondDepth := t.depth(first), t.depth(second)
+	for ; firstDepth > secondDepth; firstDepth-- {
+		first = first.GetPrevious()
+	}
+	for ; secondDepth > firstDepth; secondDepth-- {
+		second = second.GetPrevious()
+	}
+
+	for first != second {
+		first = first.GetPrevious()
+		second = second.GetPrevious()
+	}
+
+	return first
+}
+
+func (l *lifting[T]) lca(first *node.Node[T], second *node.Node[T]) *node.Node[T] {
+	a, b := l.positions[first], l.positions[second]
+	if l.depths[a] < l.depths[b] {
+		a, b = b, a
+	}
+
+	for k := len(l.ancestors) - 1; k >= 0; k-- {
+		if l.depths[a]-(1<<k) >= l.depths[b] {
+			a = l.ancestors[k][a]
+		}
+	}
+
+	if a == b {
+		return l.nodes[a]
+	}
+
+	for k := len(l.ancestors) - 1; k >= 0; k-- {
+		if l.ancestors[k][a] != l.ancestors[k][b] {
+			a = l.ancestors[k][a]
+			b = l.ancestors[k][b]
+		}
+	}
+
+	return l.nodes[l.ancestors[0][a]]
+}
@@ -0,0 +1,185 @@
+package tree_test
+
+import (
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func pathIDs(nodes []*node.Node[int]) []int {
+	var ids []int
+	for _, n := range nodes {
+		ids = append(ids, n.GetID())
+	}
+
+	return ids
+}
+
+func TestTree_LCA_ShouldRetrieveLowestCommonAncestor(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	sut := map[string]struct {
+		first, second, expected int
+	}{
+		"siblings":       {3, 5, 2},
+		"cousins":        {6, 4, 0},
+		"same node":      {4, 4, 4},
+		"ancestor":       {2, 5, 2},
+		"descendant":     {5, 0, 0},
+		"root with root": {0, 0, 0},
+	}
+
+	for _, prepared := range []bool{false, true} {
+		if prepared {
+			tr.PrepareLCA()
+		}
+
+		for name, c := range sut {
+			// Act
+			ancestor, found := tr.LCA(c.first, c.second)
+
+			// Assert
+			assert.True(t, found, name)
+			assert.Equal(t, c.expected, ancestor.GetID(), name)
+		}
+	}
+}
+
+func TestTree_LCA_WhenIDNotFound_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	_, foundFirst := tr.LCA(42, 1)
+	_, foundSecond := tr.LCA(1, 42)
+	_, foundPath := tr.PathBetween(1, 42)
+	_, foundDistance := tr.Distance(42, 1)
+
+	// Assert
+	assert.False(t, foundFirst)
+	assert.False(t, foundSecond)
+	assert.False(t, foundPath)
+	assert.False(t, foundDistance)
+}
+
+func TestTree_PathBetween_ShouldGoUpToLCAAndDown(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	cousins, _ := tr.PathBetween(6, 4)
+	ancestor, _ := tr.PathBetween(0, 5)
+	same, _ := tr.PathBetween(3, 3)
+
+	// Assert
+	assert.Equal(t, []int{6, 1, 0, 2, 4}, pathIDs(cousins))
+	assert.Equal(t, []int{0, 2, 5}, pathIDs(ancestor))
+	assert.Equal(t, []int{3}, pathIDs(same))
+}
+
+func TestTree_Distance_ShouldCountEdges(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	cousins, _ := tr.Distance(6, 4)
+	siblings, _ := tr.Distance(3, 4)
+	same, _ := tr.Distance(3, 3)
+
+	// Assert
+	assert.Equal(t, 4, cousins)
+	assert.Equal(t, 2, siblings)
+	assert.Equal(t, 0, same)
+}
+
+func TestTree_PrepareLCA_WhenTreeChanges_ShouldNotUseStaleTable(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	tr.PrepareLCA()
+
+	// Act
+	tr.Move(3, 6)
+	ancestor, _ := tr.LCA(3, 4)
+	distance, _ := tr.Distance(3, 4)
+
+	// Assert
+	assert.Equal(t, 0, ancestor.GetID())
+	assert.Equal(t, 5, distance)
+}
+
+func TestTree_PrepareLCA_WhenTreeIsRandom_ShouldMatchNaive(t *testing.T) {
+	// Arrange
+	const size = 2000
+	random := rand.New(rand.NewSource(42))
+
+	naive := tree.New[int]()
+	naive.AddRoot(node.New(0).WithID(0))
+	prepared := tree.New[int]()
+	prepared.AddRoot(node.New(0).WithID(0))
+	for i := 1; i < size; i++ {
+		parentID := random.Intn(i)
+		naive.Add(parentID, node.New(i).WithID(i))
+		prepared.Add(parentID, node.New(i).WithID(i))
+	}
+
+	prepared.PrepareLCA()
+
+	for i := 0; i < size; i++ {
+		first, second := random.Intn(size), random.Intn(size)
+
+		// Act
+		expected, _ := naive.LCA(first, second)
+		actual, _ := prepared.LCA(first, second)
+		expectedDistance, _ := naive.Distance(first, second)
+		actualDistance, _ := prepared.Distance(first, second)
+
+		// Assert
+		assert.Equal(t, expected.GetID(), actual.GetID())
+		assert.Equal(t, expectedDistance, actualDistance)
+	}
+}
+
+func BenchmarkTree_LCA(b *testing.B) {
+	tr := buildWideTree(200_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = tr.LCA(i%200_000, (i*7)%200_000)
+	}
+}
+
+func BenchmarkTree_LCA_WhenPrepared(b *testing.B) {
+	tr := buildWideTree(200_000)
+	tr.PrepareLCA()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = tr.LCA(i%200_000, (i*7)%200_000)
+	}
+}
+
+func TestTree_PrepareLCA_WhenCalledConcurrentlyWithQueries_ShouldAgree(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	results := make([]int, 4)
+
+	// Act
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tr.PrepareLCA()
+			results[i], _ = tr.Distance(6, 5)
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, []int{4, 4, 4, 4}, results)
+}
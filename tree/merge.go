@@ -0,0 +1,440 @@
+package tree
+
+import (
+	"container/list"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/johnfercher/go-tree/node"
+)
+
+// ConflictKind defines why a node could not be merged automatically.
+type ConflictKind int
+
+const (
+	// MoveMove happens when both sides moved a node to different parents.
+	MoveMove ConflictKind = iota
+	// ModifyModify happens when both sides changed data of a node differently.
+	ModifyModify
+	// DeleteModify happens when one side removed a node the other side moved or modified.
+	DeleteModify
+	// AddAdd happens when both sides added the same ID with different parent or data.
+	AddAdd
+	// ParentDeleted happens when the merge removes a node that still has nexts, the conflict ID is the removed node.
+	ParentDeleted
+	// MoveCycle happens when moves from both sides together place a node under itself.
+	MoveCycle
+)
+
+// String retrieves the name of the kind.
+func (k ConflictKind) String() string {
+	switch k {
+	case MoveMove:
+		return "move/move"
+	case ModifyModify:
+		return "modify/modify"
+	case DeleteModify:
+		return "delete/modify"
+	case AddAdd:
+		return "add/add"
+	case ParentDeleted:
+		return "parent deleted"
+	case MoveCycle:
+		return "move cycle"
+	default:
+		return fmt.Sprintf("ConflictKind(%d)", int(k))
+	}
+}
+
+// Resolution defines which version of a node wins a conflict.
+type Resolution int
+
+const (
+	// Unresolved keeps ours, except for MoveCycle where the node goes back to its base parent.
+	Unresolved Resolution = iota
+	// Ours takes the version from ours.
+	Ours
+	// Theirs takes the version from theirs.
+	Theirs
+	// Base takes the version from base.
+	Base
+)
+
+// Version is the state of a node in one of the merged trees.
+type Version[T any] struct {
+	Exists    bool
+	ParentID  int
+	HasParent bool
+	Data      T
+}
+
+// Conflict is a node that could not be merged automatically and how it was resolved.
+type Conflict[T any] struct {
+	Kind       ConflictKind
+	ID         int
+	Base       Version[T]
+	Ours       Version[T]
+	Theirs     Version[T]
+	Resolution Resolution
+}
+
+// Resolver decides a conflict, it may return Unresolved to keep the default.
+type Resolver[T any] func(conflict Conflict[T]) Resolution
+
+type merger[T any] struct {
+	eq        func(a, b T) bool
+	resolver  Resolver[T]
+	base      map[int]placement[T]
+	ours      map[int]placement[T]
+	theirs    map[int]placement[T]
+	merged    map[int]Version[T]
+	conflicts []Conflict[T]
+}
+
+// Merge3 merges changes that ours and theirs made, by node ID, from base. Independent adds, moves,
+// removes and data edits are combined, the others are reported as conflicts and decided by resolver,
+// which may be nil. Children order comes from the side that reordered them.
+func Merge3[T any](base, ours, theirs *Tree[T], eq func(a, b T) bool, resolver Resolver[T]) (*Tree[T], []Conflict[T], error) {
+	m := &merger[T]{
+		eq:       eq,
+		resolver: resolver,
+		merged:   make(map[int]Version[T]),
+	}
+	m.base, _ = placements(base)
+	m.ours, _ = placements(ours)
+	m.theirs, _ = placements(theirs)
+
+	ids := make(map[int]bool)
+	for _, placed := range []map[int]placement[T]{m.base, m.ours, m.theirs} {
+		for id := range placed {
+			ids[id] = true
+		}
+	}
+
+	for _, id := range sortedKeys(ids) {
+		if version := m.mergeNode(id); version.Exists {
+			m.merged[id] = version
+		}
+	}
+
+	m.repair()
+
+	merged, err := m.build(ours.empty())
+	if err != nil {
+		return nil, m.conflicts, err
+	}
+
+	return merged, m.conflicts, nil
+}
+
+func versionOf[T any](placed map[int]placement[T], id int) Version[T] {
+	p, found := placed[id]
+	if !found {
+		return Version[T]{}
+	}
+
+	return Version[T]{Exists: true, ParentID: p.parentID, HasParent: p.hasParent, Data: p.node.GetData()}
+}
+
+func (v Version[T]) sameParent(other Version[T]) bool {
+	return v.HasParent == other.HasParent && v.ParentID == other.ParentID
+}
+
+func (m *merger[T]) versions(id int) (Version[T], Version[T], Version[T]) {
+	return versionOf(m.base, id), versionOf(m.ours, id), versionOf(m.theirs, id)
+}
+
+func (m *merger[T]) changed(from Version[T], to Version[T]) bool {
+	return !from.sameParent(to) || !m.eq(from.Data, to.Data)
+}
+
+func (m *merger[T]) resolve(kind ConflictKind, id int) (Version[T], Resolution) {
+	conflict := Conflict[T]{Kind: kind, ID: id}
+	conflict.Base, conflict.Ours, conflict.Theirs = m.versions(id)
+
+	if m.resolver != nil {
+		conflict.Resolution = m.resolver(conflict)
+	}
+	m.conflicts = append(m.conflicts, conflict)
+
+	switch conflict.Resolution {
+	case Theirs:
+		return conflict.Theirs, conflict.Resolution
+	case Base:
+		return conflict.Base, conflict.Resolution
+	default:
+		return conflict.Ours, conflict.Resolution
+	}
+}
+
+func (m *merger[T]) mergeNode(id int) Version[T] {
+	base, ours, theirs := m.versions(id)
+
+	switch {
+	case !base.Exists && ours.Exists && theirs.Exists:
+		if ours.sameParent(theirs) && m.eq(ours.Data, theirs.Data) {
+			return ours
+		}
+		version, _ := m.resolve(AddAdd, id)
+		return version
+	case !base.Exists && ours.Exists:
+		return ours
+	case !base.Exists:
+		return theirs
+	case !ours.Exists && !theirs.Exists:
+		return Version[T]{}
+	case !ours.Exists:
+		if m.changed(base, theirs) {
+			version, _ := m.resolve(DeleteModify, id)
+			return version
+		}
+		return ours
+	case !theirs.Exists:
+		if m.changed(base, ours) {
+			version, _ := m.resolve(DeleteModify, id)
+			return version
+		}
+		return theirs
+	}
+
+	merged := ours
+	switch {
+	case ours.sameParent(base):
+		merged.ParentID, merged.HasParent = theirs.ParentID, theirs.HasParent
+	case !theirs.sameParent(base) && !ours.sameParent(theirs):
+		version, _ := m.resolve(MoveMove, id)
+		merged.ParentID, merged.HasParent = version.ParentID, version.HasParent
+	}
+
+	switch {
+	case m.eq(ours.Data, base.Data):
+		merged.Data = theirs.Data
+	case !m.eq(theirs.Data, base.Data) && !m.eq(ours.Data, theirs.Data):
+		version, _ := m.resolve(ModifyModify, id)
+		merged.Data = version.Data
+	}
+
+	return merged
+}
+
+// repair fixes nodes left under removed parents and cycles made by moves of both sides,
+// every node is decided at most once per kind so it always ends.
+func (m *merger[T]) repair() {
+	decided := make(map[int]bool)
+	reverted := make(map[int]bool)
+
+	for {
+		if m.repairOrphans(decided) {
+			continue
+		}
+		if m.repairCycles(reverted) {
+			continue
+		}
+		return
+	}
+}
+
+func (m *merger[T]) repairOrphans(decided map[int]bool) bool {
+	repaired := false
+
+	for _, id := range sortedKeys(m.merged) {
+		version, exists := m.merged[id]
+		if !exists || !version.HasParent {
+			continue
+		}
+		if _, exists := m.merged[version.ParentID]; exists {
+			continue
+		}
+
+		repaired = true
+		if !decided[version.ParentID] {
+			decided[version.ParentID] = true
+			if parent, _ := m.resolve(ParentDeleted, version.ParentID); parent.Exists {
+				m.merged[version.ParentID] = parent
+				continue
+			}
+		}
+
+		delete(m.merged, id)
+	}
+
+	return repaired
+}
+
+func (m *merger[T]) repairCycles(reverted map[int]bool) bool {
+	cycle := m.findCycle()
+	if cycle == nil {
+		return false
+	}
+
+	id := cycle[0]
+	for _, candidate := range cycle {
+		if !reverted[candidate] {
+			id = candidate
+			break
+		}
+	}
+
+	base := versionOf(m.base, id)
+	if reverted[id] || !base.Exists {
+		delete(m.merged, id)
+		return true
+	}
+	reverted[id] = true
+
+	version, resolution := m.resolve(MoveCycle, id)
+	if resolution == Unresolved || !version.Exists {
+		version = base
+	}
+
+	merged := m.merged[id]
+	merged.ParentID, merged.HasParent = version.ParentID, version.HasParent
+	m.merged[id] = merged
+
+	return true
+}
+
+// findCycle retrieves the IDs, sorted, of a cycle among merged parents.
+func (m *merger[T]) findCycle() []int {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	state := make(map[int]int)
+	for _, id := range sortedKeys(m.merged) {
+		var path []int
+		current, exists := id, true
+		for exists && state[current] == 0 {
+			state[current] = visiting
+			path = append(path, current)
+
+			var version Version[T]
+			version, exists = m.merged[current]
+			exists = exists && version.HasParent
+			current = version.ParentID
+		}
+
+		if exists && state[current] == visiting {
+			cycle := path[slices.Index(path, current):]
+			slices.Sort(cycle)
+			return cycle
+		}
+
+		for _, done := range path {
+			state[done] = visited
+		}
+	}
+
+	return nil
+}
+
+func (m *merger[T]) build(merged *Tree[T]) (*Tree[T], error) {
+	var roots []int
+	kids := make(map[int]map[int]bool)
+	for _, id := range sortedKeys(m.merged) {
+		version := m.merged[id]
+		if !version.HasParent {
+			roots = append(roots, id)
+			continue
+		}
+		if kids[version.ParentID] == nil {
+			kids[version.ParentID] = make(map[int]bool)
+		}
+		kids[version.ParentID][id] = true
+	}
+
+	if len(roots) == 0 {
+		return merged, nil
+	}
+	if len(roots) > 1 {
+		return nil, ErrMultipleRoots
+	}
+
+	merged.root = node.New(m.merged[roots[0]].Data).WithID(roots[0])
+	stack := []*node.Node[T]{merged.root}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		for _, id := range m.childrenOrder(current.GetID(), kids[current.GetID()]) {
+			next := node.New(m.merged[id].Data).WithID(id)
+			current.AddNext(next)
+			stack = append(stack, next)
+		}
+	}
+
+	merged.indexSubtree(merged.root)
+
+	return merged, nil
+}
+
+// childrenOrder retrieves kids ordered as the side that reordered them, nodes only known by
+// the other side or base are placed after the sibling that precedes them there.
+func (m *merger[T]) childrenOrder(parentID int, kids map[int]bool) []int {
+	base := childIDs(m.base, parentID)
+	primary, secondary := childIDs(m.ours, parentID), childIDs(m.theirs, parentID)
+	if slices.Equal(primary, base) {
+		primary, secondary = secondary, primary
+	}
+
+	ordered := list.New()
+	elements := make(map[int]*list.Element, len(kids))
+	for _, sequence := range [][]int{primary, secondary, base, sortedKeys(kids)} {
+		weave(ordered, elements, sequence, kids)
+	}
+
+	ids := make([]int, 0, ordered.Len())
+	for element := ordered.Front(); element != nil; element = element.Next() {
+		ids = append(ids, element.Value.(int))
+	}
+
+	return ids
+}
+
+func childIDs[T any](placed map[int]placement[T], id int) []int {
+	p, found := placed[id]
+	if !found {
+		return nil
+	}
+
+	var ids []int
+	for _, next := range p.node.GetNexts() {
+		ids = append(ids, next.GetID())
+	}
+
+	return ids
+}
+
+// weave places the kids of sequence not yet in ordered right after the closest
+// preceding node of sequence that is already there, or at the front.
+func weave(ordered *list.List, elements map[int]*list.Element, sequence []int, kids map[int]bool) {
+	var previous *list.Element
+	for _, id := range sequence {
+		if element, placed := elements[id]; placed {
+			previous = element
+			continue
+		}
+		if !kids[id] {
+			continue
+		}
+
+		if previous == nil {
+			previous = ordered.PushFront(id)
+		} else {
+			previous = ordered.InsertAfter(id, previous)
+		}
+		elements[id] = previous
+	}
+}
+
+func sortedKeys[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+
+	return keys
+}
@@ -0,0 +1,257 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func always(resolution tree.Resolution) tree.Resolver[int] {
+	return func(tree.Conflict[int]) tree.Resolution {
+		return resolution
+	}
+}
+
+func conflictKinds(conflicts []tree.Conflict[int]) []tree.ConflictKind {
+	var kinds []tree.ConflictKind
+	for _, conflict := range conflicts {
+		kinds = append(kinds, conflict.Kind)
+	}
+
+	return kinds
+}
+
+func TestMerge3_WhenChangesAreIndependent_ShouldCombineThem(t *testing.T) {
+	// Arrange
+	base := buildMoveTree()
+	ours := base.Clone()
+	ours.Add(1, node.New(7).WithID(7))
+	n, _ := ours.Get(3)
+	n.SetData(30)
+	theirs := base.Clone()
+	theirs.Move(5, 1)
+	theirs.Remove(6)
+
+	// Act
+	merged, conflicts, err := tree.Merge3(base, ours, theirs, intEq, nil)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Empty(t, conflicts)
+	structure, _ := merged.GetStructure()
+	assert.Equal(t, []string{
+		"(NULL) -> (0), ",
+		"(0) -> (1), ",
+		"(1) -> (5)",
+		"(1) -> (7)",
+		"(0) -> (2), ",
+		"(2) -> (3)",
+		"(2) -> (4)",
+	}, structure)
+	n, _ = merged.Get(3)
+	assert.Equal(t, 30, n.GetData())
+}
+
+func TestMerge3_WhenBothMovedNode_ShouldReportMoveMove(t *testing.T) {
+	// Arrange
+	base := buildMoveTree()
+	ours := base.Clone()
+	ours.Move(5, 1)
+	theirs := base.Clone()
+	theirs.Move(5, 0)
+
+	// Act
+	merged, conflicts, err := tree.Merge3(base, ours, theirs, intEq, nil)
+	resolved, _, _ := tree.Merge3(base, ours, theirs, intEq, always(tree.Theirs))
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, []tree.Conflict[int]{{
+		Kind:   tree.MoveMove,
+		ID:     5,
+		Base:   tree.Version[int]{Exists: true, ParentID: 2, HasParent: true, Data: 5},
+		Ours:   tree.Version[int]{Exists: true, ParentID: 1, HasParent: true, Data: 5},
+		Theirs: tree.Version[int]{Exists: true, ParentID: 0, HasParent: true, Data: 5},
+	}}, conflicts)
+	assert.Equal(t, []int{6, 5}, nextIDs(merged, 1))
+	assert.Equal(t, []int{1, 2, 5}, nextIDs(resolved, 0))
+}
+
+func TestMerge3_WhenBothModifiedData_ShouldUseResolution(t *testing.T) {
+	// Arrange
+	base := buildMoveTree()
+	ours := base.Clone()
+	n, _ := ours.Get(4)
+	n.SetData(40)
+	theirs := base.Clone()
+	n, _ = theirs.Get(4)
+	n.SetData(41)
+
+	// Act
+	merged, conflicts, err := tree.Merge3(base, ours, theirs, intEq, always(tree.Base))
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, []tree.ConflictKind{tree.ModifyModify}, conflictKinds(conflicts))
+	assert.Equal(t, tree.Base, conflicts[0].Resolution)
+	n, _ = merged.Get(4)
+	assert.Equal(t, 4, n.GetData())
+}
+
+func TestMerge3_WhenDeletedNodeWasModified_ShouldReportDeleteModify(t *testing.T) {
+	// Arrange
+	base := buildMoveTree()
+	ours := base.Clone()
+	ours.Remove(6)
+	theirs := base.Clone()
+	n, _ := theirs.Get(6)
+	n.SetData(60)
+
+	// Act
+	merged, conflicts, err := tree.Merge3(base, ours, theirs, intEq, nil)
+	resolved, _, _ := tree.Merge3(base, ours, theirs, intEq, always(tree.Theirs))
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, []tree.ConflictKind{tree.DeleteModify}, conflictKinds(conflicts))
+	_, found := merged.Get(6)
+	assert.False(t, found)
+	n, _ = resolved.Get(6)
+	assert.Equal(t, 60, n.GetData())
+}
+
+func TestMerge3_WhenDeletedParentWasExtended_ShouldReportParentDeleted(t *testing.T) {
+	// Arrange
+	base := buildMoveTree()
+	ours := base.Clone()
+	ours.Remove(1)
+	theirs := base.Clone()
+	theirs.Add(1, node.New(7).WithID(7))
+
+	// Act
+	merged, conflicts, err := tree.Merge3(base, ours, theirs, intEq, nil)
+	resolved, _, _ := tree.Merge3(base, ours, theirs, intEq, always(tree.Theirs))
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, []tree.ConflictKind{tree.ParentDeleted}, conflictKinds(conflicts))
+	assert.Equal(t, 1, conflicts[0].ID)
+	_, found := merged.Get(7)
+	assert.False(t, found)
+	assert.Equal(t, []int{7}, nextIDs(resolved, 1))
+}
+
+func TestMerge3_WhenMovesMakeCycle_ShouldReportMoveCycle(t *testing.T) {
+	// Arrange
+	base := buildMoveTree()
+	ours := base.Clone()
+	ours.Move(2, 1)
+	theirs := base.Clone()
+	theirs.Move(1, 2)
+
+	// Act
+	merged, conflicts, err := tree.Merge3(base, ours, theirs, intEq, nil)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, []tree.ConflictKind{tree.MoveCycle}, conflictKinds(conflicts))
+	assert.Equal(t, 1, conflicts[0].ID)
+	assert.Equal(t, []int{1}, nextIDs(merged, 0))
+	assert.Equal(t, []int{6, 2}, nextIDs(merged, 1))
+}
+
+func TestMerge3_WhenBothAddedSameID_ShouldCompareThem(t *testing.T) {
+	// Arrange
+	base := buildMoveTree()
+	ours := base.Clone()
+	ours.Add(1, node.New(7).WithID(7))
+	same := base.Clone()
+	same.Add(1, node.New(7).WithID(7))
+	different := base.Clone()
+	different.Add(1, node.New(70).WithID(7))
+
+	// Act
+	_, sameConflicts, _ := tree.Merge3(base, ours, same, intEq, nil)
+	merged, conflicts, err := tree.Merge3(base, ours, different, intEq, always(tree.Base))
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Empty(t, sameConflicts)
+	assert.Equal(t, []tree.ConflictKind{tree.AddAdd}, conflictKinds(conflicts))
+	_, found := merged.Get(7)
+	assert.False(t, found)
+}
+
+func TestMerge3_WhenOneSideReordered_ShouldKeepItsOrder(t *testing.T) {
+	// Arrange
+	base := buildMoveTree()
+	ours := base.Clone()
+	ours.Move(5, 2, tree.AtIndex(0))
+	theirs := base.Clone()
+	theirs.Add(2, node.New(7).WithID(7))
+
+	// Act
+	merged, conflicts, err := tree.Merge3(base, ours, theirs, intEq, nil)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Empty(t, conflicts)
+	assert.Equal(t, []int{5, 7, 3, 4}, nextIDs(merged, 2))
+}
+
+func TestMerge3_WhenSidesReplacedRoot_ShouldReturnError(t *testing.T) {
+	// Arrange
+	base := tree.New[int]()
+	base.AddRoot(node.New(0).WithID(0))
+	ours := tree.New[int]()
+	ours.AddRoot(node.New(8).WithID(8))
+	ours.Add(8, node.New(0).WithID(0))
+	theirs := tree.New[int]()
+	theirs.AddRoot(node.New(9).WithID(9))
+	theirs.Add(9, node.New(0).WithID(0))
+
+	// Act
+	merged, conflicts, err := tree.Merge3(base, ours, theirs, intEq, nil)
+
+	// Assert
+	assert.Nil(t, merged)
+	assert.ErrorIs(t, err, tree.ErrMultipleRoots)
+	assert.Equal(t, []tree.ConflictKind{tree.MoveMove}, conflictKinds(conflicts))
+}
+
+func TestConflictKind_String_ShouldNameKind(t *testing.T) {
+	// Assert
+	assert.Equal(t, "move/move", tree.MoveMove.String())
+	assert.Equal(t, "ConflictKind(42)", tree.ConflictKind(42).String())
+}
+
+func TestMerge3_WhenParentIsWide_ShouldOrderChildrenInLinearTime(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping wide merge in short mode")
+	}
+
+	// Arrange
+	const size = 200_000
+	base, ours, theirs := tree.New[int](), tree.New[int](), tree.New[int]()
+	for _, tr := range []*tree.Tree[int]{base, ours, theirs} {
+		tr.AddRoot(node.New(0).WithID(0))
+	}
+	for i := 1; i <= size; i++ {
+		base.Add(0, node.New(i).WithID(i))
+		ours.Add(0, node.New(size+1-i).WithID(size+1-i))
+		theirs.Add(0, node.New(i).WithID(i))
+	}
+	theirs.Add(0, node.New(size+1).WithID(size+1))
+
+	// Act
+	merged, conflicts, err := tree.Merge3(base, ours, theirs, intEq, nil)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Empty(t, conflicts)
+	ids := nextIDs(merged, 0)
+	assert.Equal(t, []int{size, size + 1, size - 1}, ids[:3])
+	assert.Equal(t, 1, ids[len(ids)-1])
+}
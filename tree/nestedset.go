@@ -0,0 +1,99 @@
+package tree
+
+import "github.com/johnfercher/go-tree/node"
+
+// NestedSet is the interval of a node in the nested set model, numbered by an Euler tour
+// starting at 1. A node is an ancestor of every node whose interval is inside its own.
+type NestedSet struct {
+	Left  int
+	Right int
+	Depth int
+}
+
+// NestedSets retrieves the nested set of every node by ID, it is computed once
+// and recomputed after Tree changes.
+func (t *Tree[T]) NestedSets() map[int]NestedSet {
+	nestedSets := t.computeNestedSets()
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	sets := make(map[int]NestedSet, len(t.index))
+	for id, n := range t.index {
+		sets[id] = nestedSets[n]
+	}
+
+	return sets
+}
+
+// NestedSet retrieves the nested set of a node.
+func (t *Tree[T]) NestedSet(id int) (NestedSet, bool) {
+	n, found := t.Get(id)
+	if !found {
+		return NestedSet{}, false
+	}
+
+	return t.computeNestedSets()[n], true
+}
+
+// IsAncestor retrieves info if ancestorID is in the path from id to root, not being id itself.
+func (t *Tree[T]) IsAncestor(ancestorID int, id int) bool {
+	ancestor, found := t.Get(ancestorID)
+	if !found {
+		return false
+	}
+
+	n, found := t.Get(id)
+	if !found {
+		return false
+	}
+
+	nestedSets := t.computeNestedSets()
+	outer, inner := nestedSets[ancestor], nestedSets[n]
+
+	return outer.Left < inner.Left && inner.Right < outer.Right
+}
+
+// EulerTour retrieves nodes in the order they are reached walking the tree,
+// a parent is repeated after each of its nexts.
+func (t *Tree[T]) EulerTour() []*node.Node[T] {
+	var tour []*node.Node[T]
+
+	t.WalkEnterExit(func(n *node.Node[T], depth int) node.WalkAction {
+		tour = append(tour, n)
+		return node.Continue
+	}, func(n *node.Node[T], depth int) {
+		if depth > 0 {
+			tour = append(tour, n.GetPrevious())
+		}
+	})
+
+	return tour
+}
+
+func (t *Tree[T]) computeNestedSets() map[*node.Node[T]]NestedSet {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.nestedSets != nil {
+		return t.nestedSets
+	}
+
+	nestedSets := make(map[*node.Node[T]]NestedSet, len(t.index))
+	counter := 0
+
+	t.WalkEnterExit(func(n *node.Node[T], depth int) node.WalkAction {
+		counter++
+		nestedSets[n] = NestedSet{Left: counter, Depth: depth}
+		return node.Continue
+	}, func(n *node.Node[T], depth int) {
+		counter++
+		set := nestedSets[n]
+		set.Right = counter
+		nestedSets[n] = set
+	})
+
+	t.nestedSets = nestedSets
+
+	return nestedSets
+}
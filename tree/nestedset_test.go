@@ -0,0 +1,140 @@
+package tree_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_NestedSets_ShouldNumberByEulerTour(t *testing.T) {
+	// Arrange
+	tr := buildJSONTree()
+
+	// Act
+	sets := tr.NestedSets()
+
+	// Assert
+	assert.Equal(t, map[int]tree.NestedSet{
+		0: {Left: 1, Right: 8, Depth: 0},
+		1: {Left: 2, Right: 5, Depth: 1},
+		3: {Left: 3, Right: 4, Depth: 2},
+		2: {Left: 6, Right: 7, Depth: 1},
+	}, sets)
+}
+
+func TestTree_NestedSet_WhenIDNotFound_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	tr := buildJSONTree()
+
+	// Act
+	_, found := tr.NestedSet(42)
+
+	// Assert
+	assert.False(t, found)
+}
+
+func TestTree_NestedSet_WhenTreeChanges_ShouldRecompute(t *testing.T) {
+	// Arrange
+	tr := buildJSONTree()
+	before, _ := tr.NestedSet(2)
+
+	// Act
+	tr.Move(2, 3)
+	after, _ := tr.NestedSet(2)
+
+	// Assert
+	assert.Equal(t, tree.NestedSet{Left: 6, Right: 7, Depth: 1}, before)
+	assert.Equal(t, tree.NestedSet{Left: 4, Right: 5, Depth: 3}, after)
+}
+
+func TestTree_IsAncestor_ShouldFollowBacktrack(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act & Assert
+	for n := range tr.PreOrder() {
+		path, _ := tr.Backtrack(n.GetID())
+
+		ancestors := make(map[int]bool)
+		for _, ancestor := range path[1:] {
+			ancestors[ancestor.GetID()] = true
+		}
+
+		for other := range tr.PreOrder() {
+			assert.Equal(t, ancestors[other.GetID()], tr.IsAncestor(other.GetID(), n.GetID()))
+		}
+	}
+}
+
+func TestTree_IsAncestor_WhenIDNotFound_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act & Assert
+	assert.False(t, tr.IsAncestor(42, 1))
+	assert.False(t, tr.IsAncestor(0, 42))
+}
+
+func TestTree_IsAncestor_WhenNodeIsRemoved_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	assert.True(t, tr.IsAncestor(2, 5))
+
+	// Act
+	tr.RemoveAndPromote(2)
+	tr.Add(1, node.New(2).WithID(2))
+
+	// Assert
+	assert.False(t, tr.IsAncestor(2, 5))
+	assert.True(t, tr.IsAncestor(1, 2))
+}
+
+func TestTree_EulerTour_ShouldRepeatParentsAfterNexts(t *testing.T) {
+	// Arrange
+	tr := buildJSONTree()
+
+	// Act
+	tour := tr.EulerTour()
+
+	// Assert
+	var ids []int
+	for _, n := range tour {
+		ids = append(ids, n.GetID())
+	}
+	assert.Equal(t, []int{0, 1, 3, 1, 0, 2, 0}, ids)
+}
+
+func TestTree_EulerTour_WhenThereIsNoRoot_ShouldReturnNil(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+
+	// Act
+	tour := tr.EulerTour()
+
+	// Assert
+	assert.Nil(t, tour)
+	assert.Empty(t, tr.NestedSets())
+}
+
+func TestTree_IsAncestor_WhenCalledConcurrently_ShouldAgree(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	results := make([]bool, 4)
+
+	// Act
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = tr.IsAncestor(2, 5)
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, []bool{true, true, true, true}, results)
+}
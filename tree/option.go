@@ -0,0 +1,34 @@
+package tree
+
+// Option customizes a Tree on creation.
+type Option func(*options)
+
+// JSONFormat defines how Tree is encoded to JSON.
+type JSONFormat int
+
+const (
+	// NestedJSON encodes Tree as {"id":..,"data":..,"children":[...]}, it holds trees
+	// until node.MaxJSONDepth, encoding deeper ones returns node.ErrJSONTooDeep.
+	NestedJSON JSONFormat = iota
+	// FlatJSON encodes Tree as [{"id":..,"parent_id":..,"data":..}] in pre-order, at any depth.
+	FlatJSON
+)
+
+type options struct {
+	allowDuplicateIDs bool
+	jsonFormat        JSONFormat
+}
+
+// WithDuplicateIDs allows nodes with the same ID, Get retrieves the first one added.
+func WithDuplicateIDs() Option {
+	return func(o *options) {
+		o.allowDuplicateIDs = true
+	}
+}
+
+// WithJSONFormat defines how Tree is encoded to JSON, decoding accepts both formats.
+func WithJSONFormat(format JSONFormat) Option {
+	return func(o *options) {
+		o.jsonFormat = format
+	}
+}
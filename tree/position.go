@@ -0,0 +1,70 @@
+package tree
+
+import "github.com/johnfercher/go-tree/node"
+
+type positionKind int
+
+const (
+	atEnd positionKind = iota
+	atIndex
+	beforeSibling
+	afterSibling
+)
+
+// Position defines where a node is placed among the nexts of its new parent.
+type Position struct {
+	kind      positionKind
+	index     int
+	siblingID int
+}
+
+// AtEnd places the node after all nexts of the new parent.
+func AtEnd() Position {
+	return Position{kind: atEnd}
+}
+
+// AtIndex places the node at an index of the nexts of the new parent.
+func AtIndex(index int) Position {
+	return Position{kind: atIndex, index: index}
+}
+
+// Before places the node before a sibling.
+func Before(siblingID int) Position {
+	return Position{kind: beforeSibling, siblingID: siblingID}
+}
+
+// After places the node after a sibling.
+func After(siblingID int) Position {
+	return Position{kind: afterSibling, siblingID: siblingID}
+}
+
+// resolve retrieves the index where n is placed once detached from its current parent.
+func resolve[T any](p Position, parent *node.Node[T], n *node.Node[T]) (int, error) {
+	var siblings []*node.Node[T]
+	for _, next := range parent.GetNexts() {
+		if next != n {
+			siblings = append(siblings, next)
+		}
+	}
+
+	switch p.kind {
+	case atIndex:
+		if p.index < 0 || p.index > len(siblings) {
+			return 0, ErrIndexOutOfRange
+		}
+		return p.index, nil
+	case beforeSibling, afterSibling:
+		for i, sibling := range siblings {
+			if sibling.GetID() != p.siblingID {
+				continue
+			}
+			if p.kind == afterSibling {
+				return i + 1, nil
+			}
+			return i, nil
+		}
+		return 0, ErrSiblingNotFound
+	default:
+		return len(siblings), nil
+	}
+}
@@ -0,0 +1,71 @@
+package tree
+
+import "github.com/johnfercher/go-tree/node"
+
+// Ancestors retrieves the nodes from the parent of a node to root.
+func (t *Tree[T]) Ancestors(id int) ([]*node.Node[T], bool) {
+	n, found := t.Get(id)
+	if !found {
+		return nil, false
+	}
+
+	return n.Ancestors(), true
+}
+
+// Descendants retrieves the sub-nodes of a node in pre-order.
+func (t *Tree[T]) Descendants(id int) ([]*node.Node[T], bool) {
+	n, found := t.Get(id)
+	if !found {
+		return nil, false
+	}
+
+	return n.Descendants(), true
+}
+
+// Siblings retrieves the other nexts of the parent of a node.
+func (t *Tree[T]) Siblings(id int) ([]*node.Node[T], bool) {
+	n, found := t.Get(id)
+	if !found {
+		return nil, false
+	}
+
+	return n.Siblings(), true
+}
+
+// NextSibling retrieves the node after a node in the nexts of its parent.
+func (t *Tree[T]) NextSibling(id int) (*node.Node[T], bool) {
+	n, found := t.Get(id)
+	if !found {
+		return nil, false
+	}
+
+	return n.NextSibling()
+}
+
+// PrevSibling retrieves the node before a node in the nexts of its parent.
+func (t *Tree[T]) PrevSibling(id int) (*node.Node[T], bool) {
+	n, found := t.Get(id)
+	if !found {
+		return nil, false
+	}
+
+	return n.PrevSibling()
+}
+
+// Leaves retrieves the leaves of Tree in pre-order.
+func (t *Tree[T]) Leaves() []*node.Node[T] {
+	if t.root == nil {
+		return nil
+	}
+
+	return t.root.Leaves()
+}
+
+// NodesAtDepth retrieves, from left to right, the nodes at a depth from root.
+func (t *Tree[T]) NodesAtDepth(depth int) []*node.Node[T] {
+	if t.root == nil {
+		return nil
+	}
+
+	return t.root.NodesAtDepth(depth)
+}
@@ -0,0 +1,61 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_Queries_WhenIDNotFound_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	_, foundAncestors := tr.Ancestors(42)
+	_, foundDescendants := tr.Descendants(42)
+	_, foundSiblings := tr.Siblings(42)
+	_, foundNext := tr.NextSibling(42)
+	_, foundPrev := tr.PrevSibling(42)
+
+	// Assert
+	assert.False(t, foundAncestors)
+	assert.False(t, foundDescendants)
+	assert.False(t, foundSiblings)
+	assert.False(t, foundNext)
+	assert.False(t, foundPrev)
+}
+
+func TestTree_Queries_WhenIDFound_ShouldDelegateToNode(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	ancestors, _ := tr.Ancestors(4)
+	descendants, _ := tr.Descendants(2)
+	siblings, _ := tr.Siblings(4)
+	next, hasNext := tr.NextSibling(4)
+	prev, hasPrev := tr.PrevSibling(4)
+
+	// Assert
+	assert.Equal(t, []int{2, 0}, pathIDs(ancestors))
+	assert.Equal(t, []int{3, 4, 5}, pathIDs(descendants))
+	assert.Equal(t, []int{3, 5}, pathIDs(siblings))
+	assert.True(t, hasNext)
+	assert.Equal(t, 5, next.GetID())
+	assert.True(t, hasPrev)
+	assert.Equal(t, 3, prev.GetID())
+}
+
+func TestTree_Leaves_WhenThereIsRoot_ShouldDelegateToRoot(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	empty := tree.New[int]()
+
+	// Act & Assert
+	assert.Equal(t, []int{6, 3, 4, 5}, pathIDs(tr.Leaves()))
+	assert.Equal(t, []int{1, 2}, pathIDs(tr.NodesAtDepth(1)))
+	assert.Equal(t, []int{6, 3, 4, 5}, pathIDs(tr.NodesAtDepth(2)))
+	assert.Nil(t, empty.Leaves())
+	assert.Nil(t, empty.NodesAtDepth(0))
+}
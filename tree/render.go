@@ -0,0 +1,165 @@
+package tree
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/johnfercher/go-tree/node"
+)
+
+// RenderOption customizes how Render draws Tree.
+type RenderOption func(*renderOptions)
+
+type renderOptions struct {
+	ascii       bool
+	color       bool
+	maxDepth    int
+	maxChildren int
+}
+
+type glyphs struct {
+	branch   string
+	last     string
+	vertical string
+	space    string
+	ellipsis string
+}
+
+var (
+	unicodeGlyphs = glyphs{branch: "├── ", last: "└── ", vertical: "│   ", space: "    ", ellipsis: "…"}
+	asciiGlyphs   = glyphs{branch: "|-- ", last: "`-- ", vertical: "|   ", space: "    ", ellipsis: "..."}
+)
+
+const (
+	ansiReset = "\x1b[0m"
+	ansiDim   = "\x1b[90m"
+)
+
+// ansiLevels colors labels by depth.
+var ansiLevels = []string{"\x1b[1;34m", "\x1b[36m", "\x1b[32m", "\x1b[33m", "\x1b[35m"}
+
+// WithASCII draws branches with |-- and `-- instead of box-drawing characters.
+func WithASCII() RenderOption {
+	return func(o *renderOptions) {
+		o.ascii = true
+	}
+}
+
+// WithColor colors branches and labels, by depth, with ANSI escape codes.
+func WithColor() RenderOption {
+	return func(o *renderOptions) {
+		o.color = true
+	}
+}
+
+// WithMaxDepth draws nodes until depth, root has depth 0, deeper nodes are summarized.
+func WithMaxDepth(depth int) RenderOption {
+	return func(o *renderOptions) {
+		o.maxDepth = depth
+	}
+}
+
+// WithMaxChildren draws at most count nexts of each node, the others are summarized.
+func WithMaxChildren(count int) RenderOption {
+	return func(o *renderOptions) {
+		o.maxChildren = count
+	}
+}
+
+type renderItem[T any] struct {
+	node   *node.Node[T]
+	prefix string
+	last   bool
+	depth  int
+	more   int
+}
+
+// Render retrieves Tree drawn like the tree command, one node per line, label describes
+// node data and defaults to fmt.Sprint.
+func (t *Tree[T]) Render(label func(obj T) string, opts ...RenderOption) (string, bool) {
+	if t.root == nil {
+		return "", false
+	}
+
+	if label == nil {
+		label = func(obj T) string {
+			return fmt.Sprint(obj)
+		}
+	}
+
+	o := renderOptions{maxDepth: -1, maxChildren: -1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	g := unicodeGlyphs
+	if o.ascii {
+		g = asciiGlyphs
+	}
+
+	lines := []string{o.label(0, label(t.root.GetData()))}
+	stack := renderNexts(t.root, "", 0, o)
+	for len(stack) > 0 {
+		item := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		connector, indent := g.branch, g.vertical
+		if item.last {
+			connector, indent = g.last, g.space
+		}
+
+		if item.node == nil {
+			lines = append(lines, item.prefix+o.dim(connector+fmt.Sprintf("%s (%d more)", g.ellipsis, item.more)))
+			continue
+		}
+
+		lines = append(lines, item.prefix+o.dim(connector)+o.label(item.depth, label(item.node.GetData())))
+		stack = append(stack, renderNexts(item.node, item.prefix+o.dim(indent), item.depth, o)...)
+	}
+
+	return strings.Join(lines, "\n"), true
+}
+
+// renderNexts retrieves the items drawn below n, in stack order.
+func renderNexts[T any](n *node.Node[T], prefix string, depth int, o renderOptions) []renderItem[T] {
+	nexts := n.GetNexts()
+	if len(nexts) == 0 {
+		return nil
+	}
+
+	if o.maxDepth >= 0 && depth >= o.maxDepth {
+		return []renderItem[T]{{prefix: prefix, last: true, more: len(nexts)}}
+	}
+
+	shown := nexts
+	if o.maxChildren >= 0 && len(nexts) > o.maxChildren {
+		shown = nexts[:o.maxChildren]
+	}
+
+	items := make([]renderItem[T], 0, len(shown)+1)
+	if hidden := len(nexts) - len(shown); hidden > 0 {
+		items = append(items, renderItem[T]{prefix: prefix, last: true, more: hidden})
+	}
+
+	for i := len(shown) - 1; i >= 0; i-- {
+		items = append(items, renderItem[T]{node: shown[i], prefix: prefix, last: len(items) == 0, depth: depth + 1})
+	}
+
+	return items
+}
+
+func (o renderOptions) dim(text string) string {
+	if !o.color {
+		return text
+	}
+
+	return ansiDim + text + ansiReset
+}
+
+func (o renderOptions) label(depth int, text string) string {
+	if !o.color {
+		return text
+	}
+
+	return ansiLevels[depth%len(ansiLevels)] + text + ansiReset
+}
@@ -0,0 +1,91 @@
+package tree_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_Render_WhenThereIsNoRoot_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+
+	// Act
+	text, ok := tr.Render(nil)
+
+	// Assert
+	assert.False(t, ok)
+	assert.Empty(t, text)
+}
+
+func TestTree_Render_ShouldDrawBranches(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	text, ok := tr.Render(func(obj int) string {
+		return fmt.Sprintf("node %d", obj)
+	})
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, "node 0\n"+
+		"├── node 1\n"+
+		"│   └── node 6\n"+
+		"└── node 2\n"+
+		"    ├── node 3\n"+
+		"    ├── node 4\n"+
+		"    └── node 5", text)
+}
+
+func TestTree_Render_WhenASCII_ShouldDrawPlainCharacters(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	text, _ := tr.Render(nil, tree.WithASCII())
+
+	// Assert
+	assert.Equal(t, "0\n"+
+		"|-- 1\n"+
+		"|   `-- 6\n"+
+		"`-- 2\n"+
+		"    |-- 3\n"+
+		"    |-- 4\n"+
+		"    `-- 5", text)
+}
+
+func TestTree_Render_WhenTruncated_ShouldSummarizeHiddenNodes(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	byChildren, _ := tr.Render(nil, tree.WithMaxChildren(1))
+	byDepth, _ := tr.Render(nil, tree.WithMaxDepth(1))
+
+	// Assert
+	assert.Equal(t, "0\n"+
+		"├── 1\n"+
+		"│   └── 6\n"+
+		"└── … (1 more)", byChildren)
+	assert.Equal(t, "0\n"+
+		"├── 1\n"+
+		"│   └── … (1 more)\n"+
+		"└── 2\n"+
+		"    └── … (3 more)", byDepth)
+}
+
+func TestTree_Render_WhenColored_ShouldUseANSICodes(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	tr.Remove(6)
+	tr.Remove(2)
+
+	// Act
+	text, _ := tr.Render(nil, tree.WithColor())
+
+	// Assert
+	assert.Equal(t, "\x1b[1;34m0\x1b[0m\n\x1b[90m└── \x1b[0m\x1b[36m1\x1b[0m", text)
+}
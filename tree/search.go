@@ -0,0 +1,57 @@
+package tree
+
+import "github.com/johnfercher/go-tree/node"
+
+// Find retrieves the first node that respects a rule.
+func (t *Tree[T]) Find(predicate func(n *node.Node[T]) bool, order node.Order) (*node.Node[T], bool) {
+	if t.root == nil {
+		return nil, false
+	}
+
+	return t.root.Find(predicate, order)
+}
+
+// FindAll retrieves all nodes that respect a rule.
+func (t *Tree[T]) FindAll(predicate func(n *node.Node[T]) bool, order node.Order) []*node.Node[T] {
+	if t.root == nil {
+		return nil
+	}
+
+	return t.root.FindAll(predicate, order)
+}
+
+// FindByData retrieves the first node of Tree with data.
+func FindByData[T comparable](t *Tree[T], data T, order node.Order) (*node.Node[T], bool) {
+	if t.root == nil {
+		return nil, false
+	}
+
+	return node.FindByData(t.root, data, order)
+}
+
+// Any retrieves info if at least one node respects a rule.
+func (t *Tree[T]) Any(predicate func(n *node.Node[T]) bool) bool {
+	if t.root == nil {
+		return false
+	}
+
+	return t.root.Any(predicate)
+}
+
+// All retrieves info if every node respects a rule, it is true for an empty Tree.
+func (t *Tree[T]) All(predicate func(n *node.Node[T]) bool) bool {
+	if t.root == nil {
+		return true
+	}
+
+	return t.root.All(predicate)
+}
+
+// Count retrieves the number of nodes that respect a rule.
+func (t *Tree[T]) Count(predicate func(n *node.Node[T]) bool) int {
+	if t.root == nil {
+		return 0
+	}
+
+	return t.root.Count(predicate)
+}
@@ -0,0 +1,67 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_Find_WhenThereIsNoRoot_ShouldNotFind(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	always := func(n *node.Node[int]) bool {
+		return true
+	}
+
+	// Act
+	_, found := tr.Find(always, node.DepthFirst)
+	_, foundByData := tree.FindByData(tr, 0, node.DepthFirst)
+
+	// Assert
+	assert.False(t, found)
+	assert.False(t, foundByData)
+	assert.Nil(t, tr.FindAll(always, node.DepthFirst))
+	assert.False(t, tr.Any(always))
+	assert.True(t, tr.All(always))
+	assert.Equal(t, 0, tr.Count(always))
+}
+
+func TestTree_Find_WhenBreadthFirst_ShouldRetrieveClosestToRoot(t *testing.T) {
+	// Arrange
+	tr := tree.New[string]()
+	tr.AddRoot(node.New("root").WithID(0))
+	tr.Add(0, node.New("dir").WithID(1))
+	tr.Add(1, node.New("match").WithID(2))
+	tr.Add(0, node.New("match").WithID(3))
+
+	// Act
+	depthFirst, _ := tree.FindByData(tr, "match", node.DepthFirst)
+	breadthFirst, _ := tree.FindByData(tr, "match", node.BreadthFirst)
+	all := tr.FindAll(func(n *node.Node[string]) bool {
+		return n.GetData() == "match"
+	}, node.BreadthFirst)
+
+	// Assert
+	assert.Equal(t, 2, depthFirst.GetID())
+	assert.Equal(t, 3, breadthFirst.GetID())
+	assert.Equal(t, 2, len(all))
+}
+
+func TestTree_Any_WhenThereIsRoot_ShouldDelegateToRoot(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	isEven := func(n *node.Node[int]) bool {
+		return n.GetData()%2 == 0
+	}
+
+	// Act & Assert
+	assert.True(t, tr.Any(isEven))
+	assert.False(t, tr.All(isEven))
+	assert.Equal(t, 4, tr.Count(isEven))
+
+	found, ok := tr.Find(isEven, node.BreadthFirst)
+	assert.True(t, ok)
+	assert.Equal(t, 0, found.GetID())
+}
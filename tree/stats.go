@@ -0,0 +1,69 @@
+package tree
+
+// Stats is the aggregate shape of Tree.
+type Stats struct {
+	// Size is the number of nodes.
+	Size int
+	// Leaves is the number of nodes without nexts.
+	Leaves int
+	// MaxDepth is the number of edges from root to the deepest leaf.
+	MaxDepth int
+	// MaxDegree is the highest number of nexts of a node.
+	MaxDegree int
+	// AvgBranchingFactor is the average number of nexts of the nodes that are not leaves.
+	AvgBranchingFactor float64
+	// WidestLevel is the depth with most nodes, the shallowest one on ties.
+	WidestLevel int
+	// WidestLevelSize is the number of nodes in WidestLevel.
+	WidestLevelSize int
+}
+
+// Stats retrieves the aggregate shape of Tree, it is computed once and recomputed after Tree changes.
+func (t *Tree[T]) Stats() Stats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stats != nil {
+		return *t.stats
+	}
+
+	stats := Stats{}
+	var levels []int
+	edges := 0
+
+	for n, depth := range t.LevelOrderWithDepth() {
+		stats.Size++
+		edges += n.Degree()
+
+		if n.IsLeaf() {
+			stats.Leaves++
+		}
+		if n.Degree() > stats.MaxDegree {
+			stats.MaxDegree = n.Degree()
+		}
+
+		if depth == len(levels) {
+			levels = append(levels, 0)
+		}
+		levels[depth]++
+	}
+
+	if len(levels) > 0 {
+		stats.MaxDepth = len(levels) - 1
+	}
+
+	if internal := stats.Size - stats.Leaves; internal > 0 {
+		stats.AvgBranchingFactor = float64(edges) / float64(internal)
+	}
+
+	for depth, size := range levels {
+		if size > stats.WidestLevelSize {
+			stats.WidestLevel = depth
+			stats.WidestLevelSize = size
+		}
+	}
+
+	t.stats = &stats
+
+	return stats
+}
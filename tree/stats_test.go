@@ -0,0 +1,77 @@
+package tree_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_Stats_WhenThereIsNoRoot_ShouldBeEmpty(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+
+	// Act
+	stats := tr.Stats()
+
+	// Assert
+	assert.Equal(t, tree.Stats{}, stats)
+}
+
+func TestTree_Stats_WhenThereIsRoot_ShouldAggregate(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	stats := tr.Stats()
+
+	// Assert
+	assert.Equal(t, tree.Stats{
+		Size:               7,
+		Leaves:             4,
+		MaxDepth:           2,
+		MaxDegree:          3,
+		AvgBranchingFactor: 2,
+		WidestLevel:        2,
+		WidestLevelSize:    4,
+	}, stats)
+}
+
+func TestTree_Stats_WhenTreeChanges_ShouldRecompute(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	before := tr.Stats()
+
+	// Act
+	tr.Add(6, node.New(7).WithID(7))
+	after := tr.Stats()
+
+	// Assert
+	assert.Equal(t, 7, before.Size)
+	assert.Equal(t, 8, after.Size)
+	assert.Equal(t, 3, after.MaxDepth)
+	assert.Equal(t, 4, after.WidestLevelSize)
+	assert.Equal(t, 2, after.WidestLevel)
+}
+
+func TestTree_Stats_WhenCalledConcurrently_ShouldAgree(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+	results := make([]int, 4)
+
+	// Act
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = tr.Stats().Size
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, []int{7, 7, 7, 7}, results)
+}
@@ -0,0 +1,70 @@
+package tree
+
+import "github.com/johnfercher/go-tree/node"
+
+// Map creates a copy of Tree with data converted by fn, keeping IDs, order and options.
+func Map[T any, U any](t *Tree[T], fn func(data T) U) *Tree[U] {
+	return MapWithContext(t, func(data T, _ node.MapContext[T]) U {
+		return fn(data)
+	})
+}
+
+// MapWithContext creates a copy of Tree with data converted by fn, keeping IDs, order and options.
+// Parents are converted before their nexts.
+func MapWithContext[T any, U any](t *Tree[T], fn func(data T, ctx node.MapContext[T]) U) *Tree[U] {
+	mapped := &Tree[U]{
+		index:   make(map[int]*node.Node[U]),
+		options: t.options,
+	}
+
+	if t.root == nil {
+		return mapped
+	}
+
+	mapped.root = node.MapWithContext(t.root, fn)
+	mapped.indexSubtree(mapped.root)
+
+	return mapped
+}
+
+// Fold accumulates Tree nodes in pre-order.
+func Fold[T any, A any](t *Tree[T], initial A, fn func(acc A, n *node.Node[T]) A) A {
+	if t.root == nil {
+		return initial
+	}
+
+	return node.Fold(t.root, initial, fn)
+}
+
+// Aggregate computes a value bottom-up for every node, retrieved by ID.
+// Leaves use leafFn and other nodes combine their data with the values of their nexts.
+func Aggregate[T any, A any](t *Tree[T], leafFn func(data T) A, combine func(data T, nexts []A) A) map[int]A {
+	values := make(map[int]A)
+
+	aggregated := AggregateTree(t, leafFn, combine)
+	for n := range aggregated.PreOrder() {
+		if _, exists := values[n.GetID()]; !exists {
+			values[n.GetID()] = n.GetData()
+		}
+	}
+
+	return values
+}
+
+// AggregateTree creates a copy of Tree holding a value computed bottom-up for every node, keeping IDs,
+// order and options. Leaves use leafFn and other nodes combine their data with the values of their nexts.
+func AggregateTree[T any, A any](t *Tree[T], leafFn func(data T) A, combine func(data T, nexts []A) A) *Tree[A] {
+	aggregated := &Tree[A]{
+		index:   make(map[int]*node.Node[A]),
+		options: t.options,
+	}
+
+	if t.root == nil {
+		return aggregated
+	}
+
+	aggregated.root = node.Aggregate(t.root, leafFn, combine)
+	aggregated.indexSubtree(aggregated.root)
+
+	return aggregated
+}
@@ -0,0 +1,149 @@
+package tree_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap_WhenThereIsNoRoot_ShouldReturnEmptyTree(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+
+	// Act
+	mapped := tree.Map(tr, strconv.Itoa)
+
+	// Assert
+	assert.NotNil(t, mapped)
+	_, hasRoot := mapped.GetRoot()
+	assert.False(t, hasRoot)
+}
+
+func TestMap_WhenThereIsRoot_ShouldKeepIDsOrderAndOptions(t *testing.T) {
+	// Arrange
+	tr := tree.New[int](tree.WithJSONFormat(tree.FlatJSON))
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(0, node.New(2).WithID(2))
+
+	// Act
+	mapped := tree.Map(tr, strconv.Itoa)
+	bytes, _ := json.Marshal(mapped)
+
+	// Assert
+	expected := `[{"id":0,"parent_id":null,"data":"0"},{"id":1,"parent_id":0,"data":"1"},{"id":2,"parent_id":0,"data":"2"}]`
+	assert.Equal(t, expected, string(bytes))
+	n, found := mapped.Get(2)
+	assert.True(t, found)
+	assert.Equal(t, "2", n.GetData())
+}
+
+func TestMapWithContext_ShouldSeeDepthAndParentData(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	mapped := tree.MapWithContext(tr, func(data int, ctx node.MapContext[int]) int {
+		if ctx.Parent == nil {
+			return data
+		}
+		return ctx.Parent.GetData()*10 + data + ctx.Depth*100
+	})
+
+	// Assert
+	n6, _ := mapped.Get(6)
+	n5, _ := mapped.Get(5)
+	root, _ := mapped.GetRoot()
+	assert.Equal(t, 216, n6.GetData())
+	assert.Equal(t, 225, n5.GetData())
+	assert.Equal(t, 0, root.GetData())
+}
+
+type task struct {
+	name string
+	cost int
+}
+
+func buildProject() *tree.Tree[task] {
+	tr := tree.New[task]()
+	tr.AddRoot(node.New(task{"project", 0}).WithID(0))
+	tr.Add(0, node.New(task{"backend", 1}).WithID(1))
+	tr.Add(1, node.New(task{"api", 5}).WithID(2))
+	tr.Add(1, node.New(task{"database", 3}).WithID(3))
+	tr.Add(0, node.New(task{"frontend", 8}).WithID(4))
+
+	return tr
+}
+
+func taskCost(data task) int {
+	return data.cost
+}
+
+func rollUp(data task, nexts []int) int {
+	total := data.cost
+	for _, next := range nexts {
+		total += next
+	}
+
+	return total
+}
+
+func TestFold_WhenThereIsNoRoot_ShouldReturnInitial(t *testing.T) {
+	// Arrange
+	tr := tree.New[task]()
+
+	// Act
+	total := tree.Fold(tr, 42, func(acc int, n *node.Node[task]) int {
+		return acc + n.GetData().cost
+	})
+
+	// Assert
+	assert.Equal(t, 42, total)
+}
+
+func TestFold_WhenThereIsRoot_ShouldAccumulateInPreOrder(t *testing.T) {
+	// Arrange
+	tr := buildProject()
+
+	// Act
+	names := tree.Fold(tr, []string(nil), func(acc []string, n *node.Node[task]) []string {
+		return append(acc, n.GetData().name)
+	})
+
+	// Assert
+	assert.Equal(t, []string{"project", "backend", "api", "database", "frontend"}, names)
+}
+
+func TestAggregate_ShouldRollUpByID(t *testing.T) {
+	// Arrange
+	tr := buildProject()
+
+	// Act
+	totals := tree.Aggregate(tr, taskCost, rollUp)
+
+	// Assert
+	assert.Equal(t, map[int]int{0: 17, 1: 9, 2: 5, 3: 3, 4: 8}, totals)
+}
+
+func TestAggregateTree_ShouldAnnotateTree(t *testing.T) {
+	// Arrange
+	tr := buildProject()
+	empty := tree.New[task]()
+
+	// Act
+	totals := tree.AggregateTree(tr, taskCost, rollUp)
+	emptyTotals := tree.AggregateTree(empty, taskCost, rollUp)
+
+	// Assert
+	backend, found := totals.Get(1)
+	assert.True(t, found)
+	assert.Equal(t, 9, backend.GetData())
+	assert.Equal(t, 2, len(backend.GetNexts()))
+	_, hasRoot := emptyTotals.GetRoot()
+	assert.False(t, hasRoot)
+	assert.Empty(t, tree.Aggregate(empty, taskCost, rollUp))
+}
@@ -0,0 +1,64 @@
+package tree
+
+import (
+	"iter"
+
+	"github.com/johnfercher/go-tree/node"
+)
+
+// PreOrder retrieves an iterator over Tree nodes, visiting parents before nexts.
+func (t *Tree[T]) PreOrder() iter.Seq[*node.Node[T]] {
+	if t.root == nil {
+		return func(func(*node.Node[T]) bool) {}
+	}
+
+	return t.root.PreOrder()
+}
+
+// PostOrder retrieves an iterator over Tree nodes, visiting nexts before parents.
+func (t *Tree[T]) PostOrder() iter.Seq[*node.Node[T]] {
+	if t.root == nil {
+		return func(func(*node.Node[T]) bool) {}
+	}
+
+	return t.root.PostOrder()
+}
+
+// LevelOrder retrieves an iterator over Tree nodes, visiting level by level.
+func (t *Tree[T]) LevelOrder() iter.Seq[*node.Node[T]] {
+	if t.root == nil {
+		return func(func(*node.Node[T]) bool) {}
+	}
+
+	return t.root.LevelOrder()
+}
+
+// PreOrderWithDepth retrieves an iterator over Tree nodes with their depth,
+// visiting parents before nexts.
+func (t *Tree[T]) PreOrderWithDepth() iter.Seq2[*node.Node[T], int] {
+	if t.root == nil {
+		return func(func(*node.Node[T], int) bool) {}
+	}
+
+	return t.root.PreOrderWithDepth()
+}
+
+// PostOrderWithDepth retrieves an iterator over Tree nodes with their depth,
+// visiting nexts before parents.
+func (t *Tree[T]) PostOrderWithDepth() iter.Seq2[*node.Node[T], int] {
+	if t.root == nil {
+		return func(func(*node.Node[T], int) bool) {}
+	}
+
+	return t.root.PostOrderWithDepth()
+}
+
+// LevelOrderWithDepth retrieves an iterator over Tree nodes with their depth,
+// visiting level by level.
+func (t *Tree[T]) LevelOrderWithDepth() iter.Seq2[*node.Node[T], int] {
+	if t.root == nil {
+		return func(func(*node.Node[T], int) bool) {}
+	}
+
+	return t.root.LevelOrderWithDepth()
+}
@@ -0,0 +1,68 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_PreOrder_WhenThereIsNoRoot_ShouldNotVisit(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	visited := 0
+
+	// Act
+	for range tr.PreOrder() {
+		visited++
+	}
+	for range tr.PostOrder() {
+		visited++
+	}
+	for range tr.LevelOrder() {
+		visited++
+	}
+	for range tr.PreOrderWithDepth() {
+		visited++
+	}
+	for range tr.PostOrderWithDepth() {
+		visited++
+	}
+	for range tr.LevelOrderWithDepth() {
+		visited++
+	}
+
+	// Assert
+	assert.Equal(t, 0, visited)
+}
+
+func TestTree_Traversals_WhenThereIsRoot_ShouldVisitInOrder(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(0, node.New(2).WithID(2))
+	tr.Add(1, node.New(3).WithID(3))
+
+	// Act
+	var pre, post, level, depths []int
+	for n := range tr.PreOrder() {
+		pre = append(pre, n.GetID())
+	}
+	for n := range tr.PostOrder() {
+		post = append(post, n.GetID())
+	}
+	for n := range tr.LevelOrder() {
+		level = append(level, n.GetID())
+	}
+	for _, depth := range tr.LevelOrderWithDepth() {
+		depths = append(depths, depth)
+	}
+
+	// Assert
+	assert.Equal(t, []int{0, 1, 3, 2}, pre)
+	assert.Equal(t, []int{3, 1, 2, 0}, post)
+	assert.Equal(t, []int{0, 1, 2, 3}, level)
+	assert.Equal(t, []int{0, 1, 1, 2}, depths)
+}
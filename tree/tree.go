@@ -1,26 +1,61 @@
 package tree
 
-import "github.com/johnfercher/go-tree/node"
+import (
+	"sync"
+
+	"github.com/johnfercher/go-tree/node"
+)
 
 // nolint:structcheck,gocritic
 // Tree represents the main entity of the package.
+// Nodes added through Tree are indexed by ID, nodes attached directly through
+// node.Node methods are indexed the first time a lookup misses them. Detaching or
+// moving nodes directly through node.Node methods leaves the index stale until Reindex.
+// Methods that don´t change Tree may be called concurrently, the others need exclusive access.
 type Tree[T any] struct {
-	root *node.Node[T]
+	root    *node.Node[T]
+	index   map[int]*node.Node[T]
+	options options
+	// mu guards what read methods compute lazily, including index entries found on a miss.
+	mu         sync.RWMutex
+	nestedSets map[*node.Node[T]]NestedSet
+	lifting    *lifting[T]
+	stats      *Stats
 }
 
 // New creates a new Tree.
-func New[T any]() *Tree[T] {
-	return &Tree[T]{}
+func New[T any](opts ...Option) *Tree[T] {
+	t := &Tree[T]{
+		index: make(map[int]*node.Node[T]),
+	}
+
+	for _, opt := range opts {
+		opt(&t.options)
+	}
+
+	return t
 }
 
 // AddRoot adds a root node to Tree.
 func (t *Tree[T]) AddRoot(n *node.Node[T]) (addedRoot bool) {
-	if t.root == nil {
-		t.root = n
-		return true
+	return t.TryAddRoot(n) == nil
+}
+
+// TryAddRoot adds a root node to Tree, retrieving why it was not added.
+func (t *Tree[T]) TryAddRoot(n *node.Node[T]) error {
+	if t.root != nil {
+		return ErrRootAlreadySet
+	}
+
+	if err := t.checkIDs(n); err != nil {
+		return err
 	}
 
-	return false
+	t.root = n
+	t.indexSubtree(n)
+	t.invalidate()
+
+	return nil
 }
 
 // GetRoot retrieves the root node from Tree.
@@ -34,34 +69,78 @@ func (t *Tree[T]) GetRoot() (root *node.Node[T], hasRoot bool) {
 
 // Add adds a node into a parent node.
 func (t *Tree[T]) Add(parentID int, node *node.Node[T]) (addedNode bool) {
+	return t.TryAdd(parentID, node) == nil
+}
+
+// TryAdd adds a node into a parent node, retrieving why it was not added.
+func (t *Tree[T]) TryAdd(parentID int, node *node.Node[T]) error {
 	if t.root == nil {
-		return false
+		return ErrNoRoot
 	}
 
-	return t.add(parentID, t.root, node)
+	parent, found := t.lookup(parentID)
+	if !found {
+		return ErrParentNotFound
+	}
+
+	// Only a node already attached may be an ancestor of parent.
+	if !node.IsRoot() || node == t.root {
+		for current := parent; current != nil; current = current.GetPrevious() {
+			if current == node {
+				return ErrCycle
+			}
+		}
+	}
+
+	if !node.IsRoot() {
+		return ErrNodeAttached
+	}
+
+	if err := t.checkIDs(node); err != nil {
+		return err
+	}
+
+	parent.AddNext(node)
+	t.indexSubtree(node)
+	t.invalidate()
+
+	return nil
 }
 
 // Get retrieves node from Tree.
 func (t *Tree[T]) Get(id int) (node *node.Node[T], found bool) {
+	node, err := t.TryGet(id)
+	return node, err == nil
+}
+
+// TryGet retrieves node from Tree, retrieving why it was not found.
+func (t *Tree[T]) TryGet(id int) (*node.Node[T], error) {
 	if t.root == nil {
-		return nil, false
+		return nil, ErrNoRoot
 	}
 
-	if t.root.GetID() == id {
-		return t.root, true
+	n, found := t.lookup(id)
+	if !found {
+		return nil, ErrNodeNotFound
 	}
 
-	return t.get(id, t.root)
+	return n, nil
 }
 
 // Backtrack retrieves a path from node to root.
 func (t *Tree[T]) Backtrack(id int) ([]*node.Node[T], bool) {
-	n, found := t.Get(id)
-	if !found {
-		return nil, found
+	nodes, err := t.TryBacktrack(id)
+	return nodes, err == nil
+}
+
+// TryBacktrack retrieves a path from node to root, retrieving why it was not found.
+func (t *Tree[T]) TryBacktrack(id int) ([]*node.Node[T], error) {
+	n, err := t.TryGet(id)
+	if err != nil {
+		return nil, err
 	}
 
-	return n.Backtrack(), true
+	return n.Backtrack(), nil
 }
 
 // GetStructure retrieves Tree structure.
@@ -75,48 +154,246 @@ func (t *Tree[T]) GetStructure() ([]string, bool) {
 
 // Filter remove all sub-nodes that doesn´t respect a rule.
 func (t *Tree[T]) Filter(filterFunc func(obj T) bool) (*Tree[T], bool) {
+	newTree, err := t.TryFilter(filterFunc)
+	return newTree, err == nil
+}
+
+// TryFilter remove all sub-nodes that doesn´t respect a rule, retrieving why it failed.
+func (t *Tree[T]) TryFilter(filterFunc func(obj T) bool) (*Tree[T], error) {
 	if t.root == nil {
-		return nil, false
+		return nil, ErrNoRoot
 	}
 
 	newRoot, ok := t.root.Filter(filterFunc)
 	if !ok {
-		return nil, false
+		return nil, ErrRootFilteredOut
+	}
+
+	newTree := t.empty()
+	newTree.root = newRoot
+	newTree.indexSubtree(newRoot)
+
+	return newTree, nil
+}
+
+// Remove detaches a node and its sub-nodes from Tree.
+func (t *Tree[T]) Remove(id int) (*node.Node[T], bool) {
+	removed, err := t.TryRemove(id)
+	return removed, err == nil
+}
+
+// TryRemove detaches a node and its sub-nodes from Tree, retrieving why it was not removed.
+func (t *Tree[T]) TryRemove(id int) (*node.Node[T], error) {
+	n, err := t.TryGet(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if n == t.root {
+		t.root = nil
+	}
+
+	n.Detach()
+	t.unindex(n, true)
+	t.invalidate()
+
+	return n, nil
+}
+
+// RemoveAndPromote removes a node from Tree placing its sub-nodes in its position.
+func (t *Tree[T]) RemoveAndPromote(id int) bool {
+	return t.TryRemoveAndPromote(id) == nil
+}
+
+// TryRemoveAndPromote removes a node from Tree placing its sub-nodes in its position,
+// retrieving why it was not removed.
+func (t *Tree[T]) TryRemoveAndPromote(id int) error {
+	n, err := t.TryGet(id)
+	if err != nil {
+		return err
+	}
+
+	if n != t.root {
+		n.DetachAndPromote()
+		t.unindex(n, false)
+		t.invalidate()
+		return nil
+	}
+
+	nexts := n.GetNexts()
+	if len(nexts) > 1 {
+		return ErrMultipleRoots
+	}
+
+	t.root = nil
+	if len(nexts) == 1 {
+		t.root = nexts[0].Detach()
+	}
+
+	t.unindex(n, false)
+	t.invalidate()
+
+	return nil
+}
+
+// Move moves a node and its sub-nodes to a new parent, by default at the end of its nexts.
+func (t *Tree[T]) Move(id int, newParentID int, position ...Position) bool {
+	return t.TryMove(id, newParentID, position...) == nil
+}
+
+// TryMove moves a node and its sub-nodes to a new parent, retrieving why it was not moved.
+func (t *Tree[T]) TryMove(id int, newParentID int, position ...Position) error {
+	n, err := t.TryGet(id)
+	if err != nil {
+		return err
+	}
+
+	parent, found := t.lookup(newParentID)
+	if !found {
+		return ErrParentNotFound
+	}
+
+	for current := parent; current != nil; current = current.GetPrevious() {
+		if current == n {
+			return ErrCycle
+		}
+	}
+
+	pos := AtEnd()
+	if len(position) > 0 {
+		pos = position[0]
+	}
+
+	index, err := resolve(pos, parent, n)
+	if err != nil {
+		return err
+	}
+
+	n.SetParentAt(parent, index)
+	t.invalidate()
+
+	return nil
+}
+
+func (t *Tree[T]) empty() *Tree[T] {
+	return &Tree[T]{
+		index:   make(map[int]*node.Node[T]),
+		options: t.options,
+	}
+}
+
+// Reindex rebuilds the ID index from root and drops cached computations, it is only
+// needed after detaching or moving nodes directly through node.Node methods.
+func (t *Tree[T]) Reindex() {
+	t.index = make(map[int]*node.Node[T])
+	t.invalidate()
+	if t.root == nil {
+		return
 	}
 
-	newTree := New[T]()
-	newTree.AddRoot(newRoot)
+	t.indexSubtree(t.root)
+}
 
-	return newTree, true
+// invalidate drops everything computed from the current shape of Tree.
+func (t *Tree[T]) invalidate() {
+	t.nestedSets = nil
+	t.lifting = nil
+	t.stats = nil
 }
 
-func (t *Tree[T]) add(parentID int, parentNode *node.Node[T], newNode *node.Node[T]) bool {
-	if parentID == parentNode.GetID() {
-		parentNode.AddNext(newNode)
-		return true
+// lookup retrieves a node by ID, only a miss walks Tree to index nodes attached
+// directly through node.Node methods.
+func (t *Tree[T]) lookup(id int) (*node.Node[T], bool) {
+	t.mu.RLock()
+	n, found := t.index[id]
+	t.mu.RUnlock()
+	if found || t.root == nil {
+		return n, found
 	}
 
-	for _, next := range parentNode.GetNexts() {
-		added := t.add(parentID, next, newNode)
-		if added {
-			return true
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.indexSubtree(t.root)
+	n, found = t.index[id]
+
+	return n, found
+}
+
+func (t *Tree[T]) unindex(n *node.Node[T], withNexts bool) {
+	removed := false
+
+	stack := []*node.Node[T]{n}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if t.index[current.GetID()] == current {
+			delete(t.index, current.GetID())
+			removed = true
+		}
+
+		if withNexts {
+			stack = append(stack, current.GetNexts()...)
 		}
 	}
 
-	return false
+	// With duplicated IDs another node may now own a removed ID.
+	if removed && t.options.allowDuplicateIDs {
+		t.Reindex()
+	}
 }
 
-func (t *Tree[T]) get(id int, parent *node.Node[T]) (*node.Node[T], bool) {
-	for _, next := range parent.GetNexts() {
-		if next.GetID() == id {
-			return next, true
+func (t *Tree[T]) checkIDs(n *node.Node[T]) error {
+	if t.options.allowDuplicateIDs {
+		return nil
+	}
+
+	if n.IsLeaf() {
+		if _, exists := t.index[n.GetID()]; exists {
+			return &DuplicateIDError{ID: n.GetID()}
 		}
+		return nil
+	}
+
+	seen := make(map[int]bool)
+	stack := []*node.Node[T]{n}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		id := current.GetID()
+		if _, exists := t.index[id]; exists || seen[id] {
+			return &DuplicateIDError{ID: id}
+		}
+		seen[id] = true
 
-		node, found := t.get(id, next)
-		if found {
-			return node, true
+		nexts := current.GetNexts()
+		for i := len(nexts) - 1; i >= 0; i-- {
+			stack = append(stack, nexts[i])
 		}
 	}
 
-	return nil, false
+	return nil
+}
+
+func (t *Tree[T]) indexSubtree(n *node.Node[T]) {
+	if t.index == nil {
+		t.index = make(map[int]*node.Node[T])
+	}
+
+	stack := []*node.Node[T]{n}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if _, exists := t.index[current.GetID()]; !exists {
+			t.index[current.GetID()] = current
+		}
+
+		nexts := current.GetNexts()
+		for i := len(nexts) - 1; i >= 0; i-- {
+			stack = append(stack, nexts[i])
+		}
+	}
 }
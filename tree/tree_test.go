@@ -1,7 +1,9 @@
 package tree_test
 
 import (
+	"errors"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/johnfercher/go-tree/node"
@@ -96,12 +98,131 @@ func TestTree_Add_WhenThereIsRootAndRootIsRight_ShouldReturnTrue(t *testing.T) {
 
 	// Act
 	_ = tr.AddRoot(node.New(42))
-	added := tr.Add(0, node.New(42))
+	added := tr.Add(0, node.New(42).WithID(1))
 
 	// Assert
 	assert.True(t, added)
 }
 
+func TestTree_Add_WhenIDAlreadyExists_ShouldReturnFalse(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+
+	// Act
+	added := tr.Add(0, node.New(2).WithID(1))
+
+	// Assert
+	assert.False(t, added)
+	n, _ := tr.Get(1)
+	assert.Equal(t, 1, n.GetData())
+}
+
+func TestTree_TryAdd_WhenIDAlreadyExists_ShouldReturnDuplicateIDError(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+
+	// Act
+	err := tr.TryAdd(1, node.New(2).WithID(0))
+
+	// Assert
+	var duplicateErr *tree.DuplicateIDError
+	assert.True(t, errors.As(err, &duplicateErr))
+	assert.Equal(t, 0, duplicateErr.ID)
+}
+
+func TestTree_TryAdd_WhenSubtreeHasDuplicateID_ShouldNotAttachSubtree(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+
+	subtree := node.New(1).WithID(1)
+	subtree.AddNext(node.New(2).WithID(2))
+	subtree.AddNext(node.New(3).WithID(2))
+
+	// Act
+	err := tr.TryAdd(0, subtree)
+
+	// Assert
+	var duplicateErr *tree.DuplicateIDError
+	assert.True(t, errors.As(err, &duplicateErr))
+	assert.Equal(t, 2, duplicateErr.ID)
+	root, _ := tr.GetRoot()
+	assert.True(t, root.IsLeaf())
+	assert.True(t, subtree.IsRoot())
+}
+
+func TestTree_TryAdd_WhenThereIsNoRoot_ShouldReturnErrNoRoot(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+
+	// Act
+	err := tr.TryAdd(0, node.New(1))
+
+	// Assert
+	assert.ErrorIs(t, err, tree.ErrNoRoot)
+}
+
+func TestTree_TryAdd_WhenParentDoesNotExist_ShouldReturnErrParentNotFound(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+
+	// Act
+	err := tr.TryAdd(5, node.New(1).WithID(1))
+
+	// Assert
+	assert.ErrorIs(t, err, tree.ErrParentNotFound)
+}
+
+func TestTree_TryAddRoot_WhenRootHasDuplicateIDs_ShouldReturnDuplicateIDError(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	root := node.New(0).WithID(0)
+	root.AddNext(node.New(1).WithID(0))
+
+	// Act
+	err := tr.TryAddRoot(root)
+
+	// Assert
+	var duplicateErr *tree.DuplicateIDError
+	assert.True(t, errors.As(err, &duplicateErr))
+	_, hasRoot := tr.GetRoot()
+	assert.False(t, hasRoot)
+}
+
+func TestTree_TryAddRoot_WhenRootAlreadySet_ShouldReturnErrRootAlreadySet(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0))
+
+	// Act
+	err := tr.TryAddRoot(node.New(1).WithID(1))
+
+	// Assert
+	assert.ErrorIs(t, err, tree.ErrRootAlreadySet)
+}
+
+func TestTree_Add_WhenDuplicateIDsAreAllowed_ShouldAddAndGetFirst(t *testing.T) {
+	// Arrange
+	tr := tree.New[int](tree.WithDuplicateIDs())
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+
+	// Act
+	added := tr.Add(0, node.New(2).WithID(1))
+
+	// Assert
+	assert.True(t, added)
+	n, _ := tr.Get(1)
+	assert.Equal(t, 1, n.GetData())
+	root, _ := tr.GetRoot()
+	assert.Equal(t, 2, len(root.GetNexts()))
+}
+
 func TestTree_Get_WhenThereIsNoRoot_ShouldReturnFalse(t *testing.T) {
 	// Arrange
 	tr := tree.New[string]()
@@ -283,3 +404,522 @@ func TestTree_Filter_WhenEverythingIsOk_ShouldWork(t *testing.T) {
 	nexts := newN0.GetNexts()
 	assert.Equal(t, 2, nexts[0].GetID())
 }
+
+func TestTree_Get_WhenNodesWereAddedDirectly_ShouldFindThem(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	root := node.New(0).WithID(0)
+	tr.AddRoot(root)
+	child := node.New(1).WithID(1)
+	root.AddNext(child)
+	child.AddNext(node.New(2).WithID(2))
+
+	// Act
+	n, found := tr.Get(2)
+	added := tr.Add(1, node.New(3).WithID(3))
+
+	// Assert
+	assert.True(t, found)
+	assert.Equal(t, 2, n.GetData())
+	assert.True(t, added)
+	assert.Equal(t, []int{2, 3}, nextIDs(tr, 1))
+}
+
+func TestTree_Get_WhenMissesAreLookedUpConcurrently_ShouldAgree(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	root := node.New(0).WithID(0)
+	tr.AddRoot(root)
+	for i := 1; i <= 4; i++ {
+		root.AddNext(node.New(i).WithID(i))
+	}
+	results := make([]bool, 4)
+
+	// Act
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, results[i] = tr.Get(i + 1)
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, []bool{true, true, true, true}, results)
+}
+
+func TestTree_Reindex_WhenNodesWereDetachedDirectly_ShouldForgetThem(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	child, _ := tr.Get(1)
+	child.Detach()
+
+	// Act
+	tr.Reindex()
+	_, found := tr.Get(1)
+	added := tr.Add(0, node.New(1).WithID(1))
+
+	// Assert
+	assert.False(t, found)
+	assert.True(t, added)
+}
+
+func TestTree_Add_WhenNodeHasSubtree_ShouldIndexWholeSubtree(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+
+	subtree := node.New(1).WithID(1)
+	subtree.AddNext(node.New(2).WithID(2))
+
+	// Act
+	added := tr.Add(0, subtree)
+	n, found := tr.Get(2)
+
+	// Assert
+	assert.True(t, added)
+	assert.True(t, found)
+	assert.Equal(t, 2, n.GetData())
+}
+
+// nolint:gomnd
+func buildWideTree(size int) *tree.Tree[int] {
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+
+	for i := 1; i < size; i++ {
+		tr.Add((i-1)/10, node.New(i).WithID(i))
+	}
+
+	return tr
+}
+
+func BenchmarkTree_Add(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = buildWideTree(200_000)
+	}
+}
+
+func BenchmarkTree_Get(b *testing.B) {
+	tr := buildWideTree(200_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = tr.Get(i % 200_000)
+	}
+}
+
+func BenchmarkTree_Backtrack(b *testing.B) {
+	tr := buildWideTree(200_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = tr.Backtrack(i % 200_000)
+	}
+}
+
+func TestTree_TryAdd_WhenNodeIsAncestorOfParent_ShouldReturnErrCycle(t *testing.T) {
+	// Arrange
+	tr := tree.New[int](tree.WithDuplicateIDs())
+	root := node.New(0).WithID(0)
+	tr.AddRoot(root)
+	tr.Add(0, node.New(1).WithID(1))
+
+	// Act
+	err := tr.TryAdd(1, root)
+
+	// Assert
+	assert.ErrorIs(t, err, tree.ErrCycle)
+}
+
+func TestTree_TryAdd_WhenNodeIsAttached_ShouldReturnErrNodeAttached(t *testing.T) {
+	// Arrange
+	tr := tree.New[int](tree.WithDuplicateIDs())
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(0, node.New(2).WithID(2))
+	attached, _ := tr.Get(1)
+
+	// Act
+	err := tr.TryAdd(2, attached)
+
+	// Assert
+	assert.ErrorIs(t, err, tree.ErrNodeAttached)
+	assert.Equal(t, []int{1, 2}, nextIDs(tr, 0))
+	assert.Empty(t, nextIDs(tr, 2))
+	assert.Equal(t, 0, attached.GetPrevious().GetID())
+}
+
+func TestTree_TryAdd_WhenIDAlreadyExists_ShouldMatchErrDuplicateID(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+
+	// Act
+	err := tr.TryAdd(0, node.New(1).WithID(0))
+
+	// Assert
+	assert.ErrorIs(t, err, tree.ErrDuplicateID)
+}
+
+func TestTree_TryGet_WhenThereIsNoRoot_ShouldReturnErrNoRoot(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+
+	// Act
+	n, err := tr.TryGet(0)
+
+	// Assert
+	assert.Nil(t, n)
+	assert.ErrorIs(t, err, tree.ErrNoRoot)
+}
+
+func TestTree_TryGet_WhenThereIsNoID_ShouldReturnErrNodeNotFound(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+
+	// Act
+	n, err := tr.TryGet(1)
+
+	// Assert
+	assert.Nil(t, n)
+	assert.ErrorIs(t, err, tree.ErrNodeNotFound)
+}
+
+func TestTree_TryBacktrack_WhenThereIsNoID_ShouldReturnErrNodeNotFound(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+
+	// Act
+	nodes, err := tr.TryBacktrack(1)
+
+	// Assert
+	assert.Nil(t, nodes)
+	assert.ErrorIs(t, err, tree.ErrNodeNotFound)
+}
+
+func TestTree_TryBacktrack_WhenIDFound_ShouldReturnPath(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+
+	// Act
+	nodes, err := tr.TryBacktrack(1)
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(nodes))
+}
+
+func TestTree_TryFilter_WhenRootDoesntRespectRule_ShouldReturnErrRootFilteredOut(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(1).WithID(1))
+
+	// Act
+	newTree, err := tr.TryFilter(func(obj int) bool {
+		return obj%2 == 0
+	})
+
+	// Assert
+	assert.Nil(t, newTree)
+	assert.ErrorIs(t, err, tree.ErrRootFilteredOut)
+}
+
+func TestTree_TryFilter_WhenThereIsNoRoot_ShouldReturnErrNoRoot(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+
+	// Act
+	newTree, err := tr.TryFilter(func(obj int) bool {
+		return true
+	})
+
+	// Assert
+	assert.Nil(t, newTree)
+	assert.ErrorIs(t, err, tree.ErrNoRoot)
+}
+
+func TestTree_Remove_WhenIDFound_ShouldDetachSubtree(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(1, node.New(2).WithID(2))
+	tr.Add(0, node.New(3).WithID(3))
+
+	// Act
+	removed, ok := tr.Remove(1)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, 1, removed.GetID())
+	assert.True(t, removed.IsRoot())
+	assert.Equal(t, 1, len(removed.GetNexts()))
+	_, found := tr.Get(1)
+	assert.False(t, found)
+	_, found = tr.Get(2)
+	assert.False(t, found)
+	root, _ := tr.GetRoot()
+	assert.Equal(t, 1, len(root.GetNexts()))
+	assert.True(t, tr.Add(0, node.New(10).WithID(2)))
+}
+
+func TestTree_Remove_WhenIDIsRoot_ShouldEmptyTree(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+
+	// Act
+	removed, ok := tr.Remove(0)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, 0, removed.GetID())
+	_, hasRoot := tr.GetRoot()
+	assert.False(t, hasRoot)
+	_, found := tr.Get(1)
+	assert.False(t, found)
+}
+
+func TestTree_TryRemove_WhenIDNotFound_ShouldReturnErrNodeNotFound(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+
+	// Act
+	removed, err := tr.TryRemove(1)
+
+	// Assert
+	assert.Nil(t, removed)
+	assert.ErrorIs(t, err, tree.ErrNodeNotFound)
+}
+
+func TestTree_Remove_WhenDuplicateIDsAreAllowed_ShouldGetRemainingDuplicate(t *testing.T) {
+	// Arrange
+	tr := tree.New[int](tree.WithDuplicateIDs())
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(0, node.New(2).WithID(1))
+
+	// Act
+	_, ok := tr.Remove(1)
+	n, found := tr.Get(1)
+
+	// Assert
+	assert.True(t, ok)
+	assert.True(t, found)
+	assert.Equal(t, 2, n.GetData())
+}
+
+func TestTree_RemoveAndPromote_WhenIDFound_ShouldKeepSubNodes(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(1, node.New(2).WithID(2))
+	tr.Add(1, node.New(3).WithID(3))
+
+	// Act
+	ok := tr.RemoveAndPromote(1)
+
+	// Assert
+	assert.True(t, ok)
+	_, found := tr.Get(1)
+	assert.False(t, found)
+	n2, _ := tr.Get(2)
+	assert.Equal(t, 0, n2.GetPrevious().GetID())
+	root, _ := tr.GetRoot()
+	assert.Equal(t, 2, len(root.GetNexts()))
+}
+
+func TestTree_RemoveAndPromote_WhenRootHasOneNext_ShouldPromoteItToRoot(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(1, node.New(2).WithID(2))
+
+	// Act
+	err := tr.TryRemoveAndPromote(0)
+
+	// Assert
+	assert.Nil(t, err)
+	root, _ := tr.GetRoot()
+	assert.Equal(t, 1, root.GetID())
+	assert.True(t, root.IsRoot())
+	_, found := tr.Get(0)
+	assert.False(t, found)
+}
+
+func TestTree_TryRemoveAndPromote_WhenRootHasManyNexts_ShouldReturnErrMultipleRoots(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(0, node.New(2).WithID(2))
+
+	// Act
+	err := tr.TryRemoveAndPromote(0)
+
+	// Assert
+	assert.ErrorIs(t, err, tree.ErrMultipleRoots)
+	root, _ := tr.GetRoot()
+	assert.Equal(t, 0, root.GetID())
+}
+
+func buildMoveTree() *tree.Tree[int] {
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(0, node.New(2).WithID(2))
+	tr.Add(2, node.New(3).WithID(3))
+	tr.Add(2, node.New(4).WithID(4))
+	tr.Add(2, node.New(5).WithID(5))
+	tr.Add(1, node.New(6).WithID(6))
+
+	return tr
+}
+
+func nextIDs[T any](tr *tree.Tree[T], id int) []int {
+	n, _ := tr.Get(id)
+
+	var ids []int
+	for _, next := range n.GetNexts() {
+		ids = append(ids, next.GetID())
+	}
+
+	return ids
+}
+
+func TestTree_Move_WhenNoPosition_ShouldAppendToNewParent(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	ok := tr.Move(1, 2)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, []int{2}, nextIDs(tr, 0))
+	assert.Equal(t, []int{3, 4, 5, 1}, nextIDs(tr, 2))
+	nodes, _ := tr.Backtrack(6)
+	assert.Equal(t, 4, len(nodes))
+}
+
+func TestTree_Move_WhenPositionIsSet_ShouldPlaceAmongSiblings(t *testing.T) {
+	// Arrange
+	sut := map[string]struct {
+		position tree.Position
+		expected []int
+	}{
+		"at end":      {tree.AtEnd(), []int{3, 4, 5, 1}},
+		"at index":    {tree.AtIndex(1), []int{3, 1, 4, 5}},
+		"before":      {tree.Before(3), []int{1, 3, 4, 5}},
+		"after":       {tree.After(4), []int{3, 4, 1, 5}},
+		"after last":  {tree.After(5), []int{3, 4, 5, 1}},
+		"index start": {tree.AtIndex(0), []int{1, 3, 4, 5}},
+	}
+
+	for name, c := range sut {
+		tr := buildMoveTree()
+
+		// Act
+		err := tr.TryMove(1, 2, c.position)
+
+		// Assert
+		assert.Nil(t, err, name)
+		assert.Equal(t, c.expected, nextIDs(tr, 2), name)
+	}
+}
+
+func TestTree_Move_WhenSameParent_ShouldReorder(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	err := tr.TryMove(5, 2, tree.Before(3))
+
+	// Assert
+	assert.Nil(t, err)
+	assert.Equal(t, []int{5, 3, 4}, nextIDs(tr, 2))
+}
+
+func TestTree_TryMove_WhenNewParentIsDescendant_ShouldReturnErrCycle(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	errDescendant := tr.TryMove(2, 3)
+	errSelf := tr.TryMove(2, 2)
+	errRoot := tr.TryMove(0, 1)
+
+	// Assert
+	assert.ErrorIs(t, errDescendant, tree.ErrCycle)
+	assert.ErrorIs(t, errSelf, tree.ErrCycle)
+	assert.ErrorIs(t, errRoot, tree.ErrCycle)
+	assert.Equal(t, []int{1, 2}, nextIDs(tr, 0))
+}
+
+func TestTree_TryMove_WhenPositionIsInvalid_ShouldReturnError(t *testing.T) {
+	// Arrange
+	tr := buildMoveTree()
+
+	// Act
+	errSibling := tr.TryMove(1, 2, tree.Before(6))
+	errIndex := tr.TryMove(1, 2, tree.AtIndex(4))
+	errParent := tr.TryMove(1, 42)
+	errNode := tr.TryMove(42, 2)
+
+	// Assert
+	assert.ErrorIs(t, errSibling, tree.ErrSiblingNotFound)
+	assert.ErrorIs(t, errIndex, tree.ErrIndexOutOfRange)
+	assert.ErrorIs(t, errParent, tree.ErrParentNotFound)
+	assert.ErrorIs(t, errNode, tree.ErrNodeNotFound)
+	assert.Equal(t, []int{1, 2}, nextIDs(tr, 0))
+}
+
+func TestTree_WhenTreeIsMillionDeep_ShouldNotOverflowStack(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping million-deep tree in short mode")
+	}
+
+	// Arrange
+	const depth = 1_000_000
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	for i := 1; i < depth; i++ {
+		tr.Add(i-1, node.New(i).WithID(i))
+	}
+
+	// Act
+	leaf, found := tr.Get(depth - 1)
+	nodes, _ := tr.Backtrack(depth - 1)
+	structure, _ := tr.GetStructure()
+	filtered, ok := tr.Filter(func(obj int) bool {
+		return obj < depth/2
+	})
+	_, foundFiltered := filtered.Get(depth/2 - 1)
+	tr.Reindex()
+	removed, removedOk := tr.Remove(1)
+	_, foundRemoved := tr.Get(depth - 1)
+
+	// Assert
+	assert.True(t, found)
+	assert.Equal(t, depth-1, leaf.GetData())
+	assert.Equal(t, depth, len(nodes))
+	assert.Equal(t, depth, len(structure))
+	assert.True(t, ok)
+	assert.True(t, foundFiltered)
+	assert.True(t, removedOk)
+	assert.Equal(t, 1, removed.GetID())
+	assert.False(t, foundRemoved)
+}
@@ -0,0 +1,22 @@
+package tree
+
+import "github.com/johnfercher/go-tree/node"
+
+// Walk visits Tree nodes, parents before nexts, with their depth.
+func (t *Tree[T]) Walk(fn func(n *node.Node[T], depth int) node.WalkAction) {
+	if t.root == nil {
+		return
+	}
+
+	t.root.Walk(fn)
+}
+
+// WalkEnterExit visits Tree nodes calling enter before visiting the nexts
+// and exit after them, exit is not called once enter returns node.Stop.
+func (t *Tree[T]) WalkEnterExit(enter func(n *node.Node[T], depth int) node.WalkAction, exit func(n *node.Node[T], depth int)) {
+	if t.root == nil {
+		return
+	}
+
+	t.root.WalkEnterExit(enter, exit)
+}
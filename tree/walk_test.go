@@ -0,0 +1,49 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/johnfercher/go-tree/node"
+	"github.com/johnfercher/go-tree/tree"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_Walk_WhenThereIsNoRoot_ShouldNotVisit(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	visited := 0
+
+	// Act
+	tr.Walk(func(n *node.Node[int], depth int) node.WalkAction {
+		visited++
+		return node.Continue
+	})
+	tr.WalkEnterExit(func(n *node.Node[int], depth int) node.WalkAction {
+		visited++
+		return node.Continue
+	}, nil)
+
+	// Assert
+	assert.Equal(t, 0, visited)
+}
+
+func TestTree_WalkEnterExit_WhenThereIsRoot_ShouldVisitAll(t *testing.T) {
+	// Arrange
+	tr := tree.New[int]()
+	tr.AddRoot(node.New(0).WithID(0))
+	tr.Add(0, node.New(1).WithID(1))
+	tr.Add(1, node.New(2).WithID(2))
+
+	// Act
+	var entered, exited []int
+	tr.WalkEnterExit(func(n *node.Node[int], depth int) node.WalkAction {
+		entered = append(entered, n.GetID())
+		return node.Continue
+	}, func(n *node.Node[int], depth int) {
+		exited = append(exited, n.GetID())
+	})
+
+	// Assert
+	assert.Equal(t, []int{0, 1, 2}, entered)
+	assert.Equal(t, []int{2, 1, 0}, exited)
+}